@@ -0,0 +1,220 @@
+package vultr
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+// userDataMaxSize is the largest user data payload accepted, measured after
+// any base64 decoding but before decompression
+const userDataMaxSize = 64 * 1024
+
+const (
+	cloudConfigHeader = "#cloud-config"
+	shebangHeader     = "#!"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// cloudinitPart is a single part of a multipart cloud-init payload
+type cloudinitPart struct {
+	ContentType string
+	Content     string
+	Filename    string
+	MergeType   string
+}
+
+// validateUserData returns a ValidateFunc which checks user data at plan time.
+// Cloud configs must parse as YAML, scripts must start with a usable shebang
+// and the payload must fit within userDataMaxSize. When base64Encoded is set
+// the value is expected to be base64 encoded, as on kubernetes node pools.
+func validateUserData(base64Encoded bool) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return warnings, errors
+		}
+
+		if v == "" {
+			return warnings, errors
+		}
+
+		payload := []byte(v)
+		if base64Encoded {
+			decoded, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				errors = append(errors, fmt.Errorf("%q must be base64 encoded: %v", k, err))
+				return warnings, errors
+			}
+			payload = decoded
+		} else if decoded, ok := decodeGzipUserData(v); ok {
+			payload = decoded
+		}
+
+		if len(payload) > userDataMaxSize {
+			errors = append(errors, fmt.Errorf(
+				"%q is %d bytes, which exceeds the limit of %d bytes", k, len(payload), userDataMaxSize))
+			return warnings, errors
+		}
+
+		if bytes.HasPrefix(payload, gzipMagic) {
+			content, err := gunzipUserData(payload)
+			if err != nil {
+				errors = append(errors, fmt.Errorf("%q could not be decompressed: %v", k, err))
+				return warnings, errors
+			}
+			payload = content
+		}
+
+		if err := validateUserDataContent(string(payload)); err != nil {
+			errors = append(errors, fmt.Errorf("%q is invalid: %v", k, err))
+		}
+
+		return warnings, errors
+	}
+}
+
+// validateUserDataContent checks the content of cloud configs and scripts.
+// Anything else, such as MIME multipart, the other #cloud-config-* formats,
+// #include or #part-handler, is passed through.
+func validateUserDataContent(content string) error {
+	firstLine, _, _ := strings.Cut(content, "\n")
+
+	switch {
+	case strings.TrimRight(firstLine, " \t\r") == cloudConfigHeader:
+		return validateCloudConfig(content)
+	case strings.HasPrefix(firstLine, shebangHeader):
+		return validateShebang(firstLine)
+	}
+
+	return nil
+}
+
+func validateCloudConfig(content string) error {
+	var doc interface{}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return fmt.Errorf("cloud-config is not valid YAML: %v", err)
+	}
+
+	switch doc.(type) {
+	case nil, map[interface{}]interface{}:
+		return nil
+	default:
+		return fmt.Errorf("cloud-config must be a YAML mapping")
+	}
+}
+
+func validateShebang(line string) error {
+	fields := strings.Fields(strings.TrimPrefix(line, shebangHeader))
+	if len(fields) == 0 {
+		return fmt.Errorf("script shebang does not name an interpreter")
+	}
+
+	if !strings.HasPrefix(fields[0], "/") {
+		return fmt.Errorf("script shebang interpreter %q must be an absolute path", fields[0])
+	}
+
+	return nil
+}
+
+// decodeGzipUserData reports whether user data is a base64 encoded gzip
+// payload, as rendered by vultr_cloudinit_config, and returns the raw bytes
+func decodeGzipUserData(userData string) ([]byte, bool) {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil || !bytes.HasPrefix(decoded, gzipMagic) {
+		return nil, false
+	}
+
+	return decoded, true
+}
+
+func gunzipUserData(payload []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close() //nolint:errcheck
+
+	return io.ReadAll(zr)
+}
+
+// encodeUserData base64 encodes user data for the API. Gzip payloads can only
+// be supplied already encoded, so those are passed through as they are.
+func encodeUserData(userData string) string {
+	if _, ok := decodeGzipUserData(userData); ok {
+		return userData
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(userData))
+}
+
+// renderCloudinitConfig assembles parts into a MIME multipart payload,
+// optionally gzip compressed and base64 encoded
+func renderCloudinitConfig(parts []cloudinitPart, boundary string, gzipOutput, base64Encode bool) (string, error) {
+	var buf bytes.Buffer
+
+	var w io.Writer = &buf
+	var zw *gzip.Writer
+	if gzipOutput {
+		zw = gzip.NewWriter(&buf)
+		w = zw
+	}
+
+	mw := multipart.NewWriter(w)
+	if err := mw.SetBoundary(boundary); err != nil {
+		return "", fmt.Errorf("invalid boundary %q: %v", boundary, err)
+	}
+
+	header := fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+	if _, err := io.WriteString(w, header); err != nil {
+		return "", err
+	}
+
+	for i, part := range parts {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", part.ContentType)
+		h.Set("MIME-Version", "1.0")
+		h.Set("Content-Transfer-Encoding", "7bit")
+		if part.Filename != "" {
+			h.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", part.Filename))
+		}
+		if part.MergeType != "" {
+			h.Set("X-Merge-Type", part.MergeType)
+		}
+
+		pw, err := mw.CreatePart(h)
+		if err != nil {
+			return "", fmt.Errorf("error writing part %d: %v", i, err)
+		}
+
+		if _, err := io.WriteString(pw, part.Content); err != nil {
+			return "", fmt.Errorf("error writing part %d: %v", i, err)
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	if zw != nil {
+		if err := zw.Close(); err != nil {
+			return "", err
+		}
+	}
+
+	if base64Encode {
+		return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+	}
+
+	return buf.String(), nil
+}
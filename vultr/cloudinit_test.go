@@ -0,0 +1,38 @@
+package vultr
+
+import "testing"
+
+func TestValidateUserDataContent(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"cloud-config", "#cloud-config\npackages:\n  - nginx\n", false},
+		{"cloud-config trailing whitespace", "#cloud-config \t\r\npackages:\n  - nginx\n", false},
+		{"cloud-config empty", "#cloud-config\n", false},
+		{"cloud-config invalid yaml", "#cloud-config\npackages: [nginx\n", true},
+		{"cloud-config not a mapping", "#cloud-config\n- nginx\n", true},
+		{"cloud-config-archive", "#cloud-config-archive\n- type: text/cloud-config\n  content: '#cloud-config'\n", false},
+		{"cloud-config-jsonp", "#cloud-config-jsonp\n[{\"op\": \"add\"}]\n", false},
+		{"include", "#include\nhttps://example.com/cloud-config.yaml\n", false},
+		{"part-handler", "#part-handler\ndef list_types():\n    return []\n", false},
+		{"script", "#!/bin/bash\necho hello\n", false},
+		{"script with env", "#!/usr/bin/env python3\nprint('hello')\n", false},
+		{"script without interpreter", "#!\necho hello\n", true},
+		{"script with relative interpreter", "#!bash\necho hello\n", true},
+		{"plain data", "hello world", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateUserDataContent(tc.content)
+			if tc.wantErr && err == nil {
+				t.Fatalf("expected an error for %q", tc.content)
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error for %q: %v", tc.content, err)
+			}
+		})
+	}
+}
@@ -23,6 +23,11 @@ type Config struct {
 // Client wraps govultr
 type Client struct {
 	client *govultr.Client
+
+	// lbLocks serializes every change to a load balancer, keyed by load
+	// balancer ID. vultr_load_balancer takes it too, along with the rule and
+	// attachment resources that modify the same load balancer.
+	lbLocks *mutexKV
 }
 
 func (c *Client) govultrClient() *govultr.Client {
@@ -67,5 +72,5 @@ func (c *Config) Client() (*Client, error) {
 		vultrClient.SetRetryLimit(c.RetryLimit)
 	}
 
-	return &Client{client: vultrClient}, nil
+	return &Client{client: vultrClient, lbLocks: newMutexKV()}, nil
 }
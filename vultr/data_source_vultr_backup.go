@@ -2,17 +2,55 @@ package vultr
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vultr/govultr/v3"
 )
 
+// govultr's backup list options don't include the instance_id query param,
+// so backups are listed directly through the authenticated client
+const backupsPath = "/v2/backups"
+
+type backupsList struct {
+	Backups []govultr.Backup `json:"backups"`
+	Meta    *govultr.Meta    `json:"meta"`
+}
+
 func dataSourceVultrBackup() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrBackupRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"backups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -26,45 +64,84 @@ func dataSourceVultrBackupRead(ctx context.Context, d *schema.ResourceData, meta
 	client := meta.(*Client).govultrClient()
 
 	filters, filtersOk := d.GetOk("filter")
+	instanceID, instanceOk := d.GetOk("instance_id")
+	status, statusOk := d.GetOk("status")
 
-	if !filtersOk {
-		return diag.Errorf("issue with filter: %v", filtersOk)
+	if !filtersOk && !instanceOk && !statusOk {
+		return diag.Errorf("one of filter, instance_id or status must be set")
 	}
 
-	var backupList []map[string]interface{}
+	minSize, minSizeOk := d.GetOk("min_size")
+	maxSize, maxSizeOk := d.GetOk("max_size")
+
+	var backups []govultr.Backup
 	f := buildVultrDataSourceFilter(filters.(*schema.Set))
-	options := &govultr.ListOptions{}
+	query := url.Values{}
+	if instanceOk {
+		query.Set("instance_id", instanceID.(string))
+	}
 
 	for {
-		backups, meta, _, err := client.Backup.List(ctx, options)
+		req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s?%s", backupsPath, query.Encode()), nil)
 		if err != nil {
+			return diag.Errorf("error building backups request: %v", err)
+		}
+
+		list := new(backupsList)
+		if _, err = client.DoWithContext(ctx, req, list); err != nil {
 			return diag.Errorf("error getting backups: %v", err)
 		}
 
-		for _, b := range backups {
+		for _, b := range list.Backups {
+			if statusOk && b.Status != status.(string) {
+				continue
+			}
+
+			if minSizeOk && b.Size < minSize.(int) {
+				continue
+			}
+
+			if maxSizeOk && b.Size > maxSize.(int) {
+				continue
+			}
+
 			// We need convert the struct into a map. This allows us to easily manipulate the data here.
 			sm, err := structToMap(b)
 			if err != nil {
 				return diag.FromErr(err)
 			}
 
-			if filterLoop(f, sm) {
-				backupList = append(backupList, sm)
+			if filtersOk && !filterLoop(f, sm) {
+				continue
 			}
+
+			backups = append(backups, b)
 		}
 
-		if meta.Links.Next == "" {
+		if list.Meta == nil || list.Meta.Links == nil || list.Meta.Links.Next == "" {
 			break
-		} else {
-			options.Cursor = meta.Links.Next
-			continue
 		}
+		query.Set("cursor", list.Meta.Links.Next)
 	}
 
-	if len(backupList) < 1 {
+	if len(backups) < 1 {
 		return diag.Errorf("no results were found")
 	}
 
+	if d.Get("most_recent").(bool) {
+		sortBackupsNewestFirst(backups)
+		backups = backups[:1]
+	}
+
+	var backupList []map[string]interface{}
+	for _, b := range backups {
+		sm, err := structToMap(b)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		backupList = append(backupList, sm)
+	}
+
 	d.SetId(backupList[0]["description"].(string))
 	if err := d.Set("backups", backupList); err != nil {
 		return diag.Errorf("error setting `backups`: %#v", err)
@@ -72,3 +149,16 @@ func dataSourceVultrBackupRead(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+// sortBackupsNewestFirst orders backups by creation date, newest first.
+// Dates that fail to parse sort last.
+func sortBackupsNewestFirst(backups []govultr.Backup) {
+	sort.SliceStable(backups, func(i, j int) bool {
+		ti, errI := time.Parse(time.RFC3339, backups[i].DateCreated)
+		tj, errJ := time.Parse(time.RFC3339, backups[j].DateCreated)
+		if errI != nil || errJ != nil {
+			return errJ != nil && errI == nil
+		}
+		return ti.After(tj)
+	})
+}
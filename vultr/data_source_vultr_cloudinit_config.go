@@ -0,0 +1,124 @@
+package vultr
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceVultrCloudinitConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrCloudinitConfigRead,
+		Schema: map[string]*schema.Schema{
+			"part": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "text/cloud-config",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"filename": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"merge_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"gzip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"base64_encode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MIMEBOUNDARY",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"rendered": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVultrCloudinitConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	gzipOutput := d.Get("gzip").(bool)
+	base64Encode := d.Get("base64_encode").(bool)
+
+	if gzipOutput && !base64Encode {
+		return diag.Errorf("gzip output must be base64 encoded, please set base64_encode to true")
+	}
+
+	var parts []cloudinitPart
+	for i, v := range d.Get("part").([]interface{}) {
+		p := v.(map[string]interface{})
+		part := cloudinitPart{
+			ContentType: p["content_type"].(string),
+			Content:     p["content"].(string),
+			Filename:    p["filename"].(string),
+			MergeType:   p["merge_type"].(string),
+		}
+
+		if err := validateCloudinitPart(part); err != nil {
+			return diag.Errorf("part %d is invalid: %v", i, err)
+		}
+
+		parts = append(parts, part)
+	}
+
+	rendered, err := renderCloudinitConfig(parts, d.Get("boundary").(string), gzipOutput, base64Encode)
+	if err != nil {
+		return diag.Errorf("error rendering cloud-init config: %v", err)
+	}
+
+	sum := sha256.Sum256([]byte(rendered))
+	d.SetId(hex.EncodeToString(sum[:]))
+	if err := d.Set("rendered", rendered); err != nil {
+		return diag.Errorf("unable to set `rendered` read value: %v", err)
+	}
+
+	return nil
+}
+
+// validateCloudinitPart checks the content of cloud config and shell script
+// parts, which cloud-init identifies by content type rather than header
+func validateCloudinitPart(part cloudinitPart) error {
+	switch part.ContentType {
+	case "text/cloud-config":
+		return validateCloudConfig(part.Content)
+	case "text/x-shellscript":
+		firstLine, _, _ := strings.Cut(part.Content, "\n")
+		if !strings.HasPrefix(firstLine, shebangHeader) {
+			return fmt.Errorf("shell scripts must start with a shebang")
+		}
+		return validateShebang(strings.TrimRight(firstLine, "\r"))
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package vultr
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrCloudinitConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVultrCloudinitConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vultr_cloudinit_config.test", "rendered"),
+					resource.TestMatchResourceAttr("data.vultr_cloudinit_config.plain", "rendered", regexp.MustCompile(`Content-Type: text/x-shellscript`)),
+				),
+			},
+			{
+				Config:      testAccCheckVultrCloudinitConfigInvalid(),
+				ExpectError: regexp.MustCompile(`cloud-config is not valid YAML`),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrCloudinitConfig() string {
+	return `
+		data "vultr_cloudinit_config" "test" {
+			part {
+				content = "#cloud-config\npackages:\n  - git\n"
+			}
+		}
+
+		data "vultr_cloudinit_config" "plain" {
+			gzip          = false
+			base64_encode = false
+
+			part {
+				content_type = "text/x-shellscript"
+				content      = "#!/bin/bash\necho hello\n"
+			}
+		}`
+}
+
+func testAccCheckVultrCloudinitConfigInvalid() string {
+	return `
+		data "vultr_cloudinit_config" "test" {
+			part {
+				content = "#cloud-config\npackages: [git\n"
+			}
+		}`
+}
@@ -0,0 +1,97 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrDatabaseAlerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabaseAlertsRead,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "day",
+				ValidateFunc: validation.StringInSlice([]string{"day", "week", "month", "year"}, false),
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"recommendation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_scheduled": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVultrDatabaseAlertsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+	period := d.Get("period").(string)
+
+	alerts, _, err := client.Database.ListServiceAlerts(ctx, databaseID, &govultr.DatabaseListAlertsReq{Period: period})
+	if err != nil {
+		return diag.Errorf("error getting alerts for database %s: %v", databaseID, err)
+	}
+
+	var alertList []map[string]interface{}
+	for i := range alerts {
+		alertList = append(alertList, map[string]interface{}{
+			"timestamp":             alerts[i].Timestamp,
+			"message_type":          alerts[i].MessageType,
+			"description":           alerts[i].Description,
+			"recommendation":        alerts[i].Recommendation,
+			"maintenance_scheduled": alerts[i].MaintenanceScheduled,
+			"resource_type":         alerts[i].ResourceType,
+			"table_count":           alerts[i].TableCount,
+		})
+	}
+
+	d.SetId(databaseID + "-" + period)
+	if err := d.Set("alerts", alertList); err != nil {
+		return diag.Errorf("unable to set database_alerts `alerts` read value: %v", err)
+	}
+
+	return nil
+}
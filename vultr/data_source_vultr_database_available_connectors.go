@@ -0,0 +1,141 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceVultrDatabaseAvailableConnectors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabaseAvailableConnectorsRead,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"class": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"connectors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"doc_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration_schema": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVultrDatabaseAvailableConnectorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+	class := d.Get("class").(string)
+
+	connectors, _, err := client.Database.ListAvailableConnectors(ctx, databaseID)
+	if err != nil {
+		return diag.Errorf("error getting available connectors for database %s: %v", databaseID, err)
+	}
+
+	var connectorList []map[string]interface{}
+	for i := range connectors {
+		if class != "" && connectors[i].Class != class {
+			continue
+		}
+
+		connectorList = append(connectorList, map[string]interface{}{
+			"class":   connectors[i].Class,
+			"title":   connectors[i].Title,
+			"version": connectors[i].Version,
+			"type":    connectors[i].Type,
+			"doc_url": connectors[i].DocURL,
+		})
+	}
+
+	var optionList []map[string]interface{}
+	if class != "" {
+		if len(connectorList) == 0 {
+			return diag.Errorf("connector class %s is not available for database %s", class, databaseID)
+		}
+
+		options, _, err := client.Database.GetConnectorConfigurationSchema(ctx, databaseID, class)
+		if err != nil {
+			return diag.Errorf("error getting configuration schema for connector class %s: %v", class, err)
+		}
+
+		for i := range options {
+			optionList = append(optionList, map[string]interface{}{
+				"name":          options[i].Name,
+				"type":          options[i].Type,
+				"required":      options[i].Required,
+				"default_value": options[i].DefaultValue,
+				"description":   options[i].Description,
+			})
+		}
+	}
+
+	d.SetId(databaseID + class)
+	if err := d.Set("connectors", connectorList); err != nil {
+		return diag.Errorf("unable to set database_available_connectors `connectors` read value: %v", err)
+	}
+	if err := d.Set("configuration_schema", optionList); err != nil {
+		return diag.Errorf("unable to set database_available_connectors `configuration_schema` read value: %v", err)
+	}
+
+	return nil
+}
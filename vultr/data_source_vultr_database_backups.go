@@ -0,0 +1,93 @@
+package vultr
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrDatabaseBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabaseBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"latest_backup": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: databaseBackupSchema()},
+			},
+			"oldest_backup": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: databaseBackupSchema()},
+			},
+		},
+	}
+}
+
+func databaseBackupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"date": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"timestamp": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceVultrDatabaseBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+
+	backups, _, err := client.Database.GetBackupInformation(ctx, databaseID)
+	if err != nil {
+		return diag.Errorf("error getting backups for database %s: %v", databaseID, err)
+	}
+
+	d.SetId(databaseID)
+	if err := d.Set("latest_backup", flattenDatabaseBackup(backups.LatestBackup)); err != nil {
+		return diag.Errorf("unable to set database_backups `latest_backup` read value: %v", err)
+	}
+	if err := d.Set("oldest_backup", flattenDatabaseBackup(backups.OldestBackup)); err != nil {
+		return diag.Errorf("unable to set database_backups `oldest_backup` read value: %v", err)
+	}
+
+	return nil
+}
+
+// flattenDatabaseBackup flattens a backup, adding an RFC 3339 timestamp which
+// can be used as a restore_from point_in_time
+func flattenDatabaseBackup(backup govultr.DatabaseBackup) []map[string]interface{} {
+	if backup.Date == "" {
+		return nil
+	}
+
+	timestamp := ""
+	if t, err := time.Parse("2006-01-02 15:04:05", backup.Date+" "+backup.Time); err == nil {
+		timestamp = t.UTC().Format(time.RFC3339)
+	}
+
+	return []map[string]interface{}{
+		{
+			"date":      backup.Date,
+			"time":      backup.Time,
+			"timestamp": timestamp,
+		},
+	}
+}
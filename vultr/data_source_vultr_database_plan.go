@@ -0,0 +1,175 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+func dataSourceVultrDatabasePlan() *schema.Resource {
+	s := databasePlanSchema()
+	s["filter"] = dataSourceFiltersSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabasePlanRead,
+		Schema:      s,
+	}
+}
+
+func databasePlanSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"number_of_nodes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vcpu_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"ram": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"disk": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"monthly_cost": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"supported_engines": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"max_connections": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+		"locations": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func dataSourceVultrDatabasePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filters, filtersOk := d.GetOk("filter")
+	if !filtersOk {
+		return diag.Errorf("issue with filter: %v", filtersOk)
+	}
+
+	planList, err := listVultrDatabasePlans(ctx, meta, buildVultrDataSourceFilter(filters.(*schema.Set)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(planList) > 1 {
+		return diag.Errorf("your search returned too many results. Please refine your search to be more specific")
+	}
+
+	if len(planList) < 1 {
+		return diag.Errorf("no results were found")
+	}
+
+	d.SetId(planList[0].ID)
+	for k, v := range flattenDatabasePlan(&planList[0]) {
+		if k == "id" {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return diag.Errorf("unable to set database_plan `%s` read value: %v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// listVultrDatabasePlans returns the database plans matching the filters.
+// supported_engines and max_connections are flattened the same way as the
+// data source attributes so they can be filtered on, e.g. by engine.
+func listVultrDatabasePlans(ctx context.Context, meta interface{}, f []filter) ([]govultr.DatabasePlan, error) {
+	client := meta.(*Client).govultrClient()
+
+	plans, _, _, err := client.Database.ListPlans(ctx, &govultr.DBPlanListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting database plans: %v", err)
+	}
+
+	var planList []govultr.DatabasePlan
+	for i := range plans {
+		// we need convert the a struct INTO a map so we can easily manipulate the data here
+		sm, err := structToMap(plans[i])
+		if err != nil {
+			return nil, err
+		}
+
+		engines := make([]interface{}, 0)
+		for _, engine := range databasePlanEngines(&plans[i]) {
+			engines = append(engines, engine)
+		}
+		sm["supported_engines"] = engines
+		delete(sm, "max_connections")
+
+		if filterLoop(f, sm) {
+			planList = append(planList, plans[i])
+		}
+	}
+
+	return planList, nil
+}
+
+// databasePlanEngines returns the names of the engines supported by a plan,
+// as used in vultr_database.database_engine
+func databasePlanEngines(plan *govultr.DatabasePlan) []string {
+	var engines []string
+	for _, e := range []struct {
+		name      string
+		supported *bool
+	}{
+		{"mysql", plan.SupportedEngines.MySQL},
+		{"pg", plan.SupportedEngines.PG},
+		{"valkey", plan.SupportedEngines.Valkey},
+		{"kafka", plan.SupportedEngines.Kafka},
+	} {
+		if e.supported != nil && *e.supported {
+			engines = append(engines, e.name)
+		}
+	}
+	return engines
+}
+
+func flattenDatabasePlan(plan *govultr.DatabasePlan) map[string]interface{} {
+	maxConnections := map[string]interface{}{}
+	if plan.MaxConnections != nil {
+		if plan.MaxConnections.MySQL != 0 {
+			maxConnections["mysql"] = plan.MaxConnections.MySQL
+		}
+		if plan.MaxConnections.PG != 0 {
+			maxConnections["pg"] = plan.MaxConnections.PG
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                plan.ID,
+		"number_of_nodes":   plan.NumberOfNodes,
+		"type":              plan.Type,
+		"vcpu_count":        plan.VCPUCount,
+		"ram":               plan.RAM,
+		"disk":              plan.Disk,
+		"monthly_cost":      plan.MonthlyCost,
+		"supported_engines": databasePlanEngines(plan),
+		"max_connections":   maxConnections,
+		"locations":         plan.Locations,
+	}
+}
@@ -0,0 +1,67 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrDatabasePlan(t *testing.T) {
+	name := "data.vultr_database_plan.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVultrDatabasePlan("vultr-dbaas-startup-cc-1-55-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", "vultr-dbaas-startup-cc-1-55-2"),
+					resource.TestCheckResourceAttr(name, "number_of_nodes", "1"),
+					resource.TestCheckResourceAttr(name, "vcpu_count", "1"),
+					resource.TestCheckResourceAttr(name, "disk", "55"),
+					resource.TestCheckResourceAttrSet(name, "ram"),
+					resource.TestCheckResourceAttrSet(name, "monthly_cost"),
+					resource.TestCheckResourceAttrSet(name, "max_connections.pg"),
+					resource.TestCheckTypeSetElemAttr(name, "supported_engines.*", "pg"),
+					resource.TestCheckTypeSetElemAttr(name, "locations.*", "ewr"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_plans.test", "plans.#"),
+					resource.TestCheckTypeSetElemAttr("data.vultr_database_plans.test", "plans.*.id",
+						"vultr-dbaas-startup-cc-1-55-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrDatabasePlan(id string) string {
+	return fmt.Sprintf(`
+		data "vultr_database_plan" "test" {
+			filter {
+				name = "id"
+				values = ["%s"]
+			}
+
+			filter {
+				name = "supported_engines"
+				values = ["pg"]
+			}
+		}
+
+		data "vultr_database_plans" "test" {
+			filter {
+				name = "supported_engines"
+				values = ["pg"]
+			}
+
+			filter {
+				name = "locations"
+				values = ["ewr"]
+			}
+
+			filter {
+				name = "number_of_nodes"
+				values = ["1"]
+			}
+		}`, id)
+}
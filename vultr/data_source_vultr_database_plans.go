@@ -0,0 +1,54 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVultrDatabasePlans() *schema.Resource {
+	planSchema := databasePlanSchema()
+	planSchema["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabasePlansRead,
+		Schema: map[string]*schema.Schema{
+			"filter": dataSourceFiltersSchema(),
+			"plans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: planSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVultrDatabasePlansRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var f []filter
+	if filters, filtersOk := d.GetOk("filter"); filtersOk {
+		f = buildVultrDataSourceFilter(filters.(*schema.Set))
+	}
+
+	planList, err := listVultrDatabasePlans(ctx, meta, f)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plans := make([]map[string]interface{}, 0, len(planList))
+	for i := range planList {
+		plans = append(plans, flattenDatabasePlan(&planList[i]))
+	}
+
+	d.SetId("database_plans")
+	if err := d.Set("plans", plans); err != nil {
+		return diag.Errorf("unable to set database_plans `plans` read value: %v", err)
+	}
+
+	return nil
+}
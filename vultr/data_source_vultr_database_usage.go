@@ -0,0 +1,120 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceVultrDatabaseUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrDatabaseUsageRead,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"disk": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_gb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"max_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"memory": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_mb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"max_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"cpu": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percentage": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVultrDatabaseUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+
+	usage, _, err := client.Database.GetUsage(ctx, databaseID)
+	if err != nil {
+		return diag.Errorf("error getting usage for database %s: %v", databaseID, err)
+	}
+
+	d.SetId(databaseID)
+
+	disk := []map[string]interface{}{
+		{
+			"current_gb": usage.Disk.CurrentGB,
+			"max_gb":     usage.Disk.MaxGB,
+			"percentage": usage.Disk.Percentage,
+		},
+	}
+	if err := d.Set("disk", disk); err != nil {
+		return diag.Errorf("unable to set database_usage `disk` read value: %v", err)
+	}
+
+	memory := []map[string]interface{}{
+		{
+			"current_mb": usage.Memory.CurrentMB,
+			"max_mb":     usage.Memory.MaxMB,
+			"percentage": usage.Memory.Percentage,
+		},
+	}
+	if err := d.Set("memory", memory); err != nil {
+		return diag.Errorf("unable to set database_usage `memory` read value: %v", err)
+	}
+
+	cpu := []map[string]interface{}{
+		{
+			"percentage": usage.CPU.Percentage,
+		},
+	}
+	if err := d.Set("cpu", cpu); err != nil {
+		return diag.Errorf("unable to set database_usage `cpu` read value: %v", err)
+	}
+
+	return nil
+}
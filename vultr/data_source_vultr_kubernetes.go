@@ -68,6 +68,29 @@ func dataSourceVultrKubernetes() *schema.Resource {
 					Schema: nodePoolSchema(false),
 				},
 			},
+			"kube_config_context_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config_rendered": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"kube_config": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -140,11 +163,6 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("error getting kubeconfig")
 	}
 
-	ca, cert, key, err := getCertsFromKubeConfig(kubeConfig.KubeConfig)
-	if err != nil {
-		return diag.Errorf("error getting certs from kubeconfig : %v", err)
-	}
-
 	d.SetId(k8List[0].ID)
 	if err := d.Set("label", k8List[0].Label); err != nil {
 		return diag.Errorf("unable to set kubernetes `label` read value: %v", err)
@@ -179,17 +197,8 @@ func dataSourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("status", k8List[0].Status); err != nil {
 		return diag.Errorf("unable to set kubernetes `status` read value: %v", err)
 	}
-	if err := d.Set("kube_config", kubeConfig.KubeConfig); err != nil {
-		return diag.Errorf("unable to set kubernetes `kube_config` read value: %v", err)
-	}
-	if err := d.Set("cluster_ca_certificate", ca); err != nil {
-		return diag.Errorf("unable to set kubernetes `cluster_ca_certificate` read value: %v", err)
-	}
-	if err := d.Set("client_certificate", cert); err != nil {
-		return diag.Errorf("unable to set kubernetes `client_certificate` read value: %v", err)
-	}
-	if err := d.Set("client_key", key); err != nil {
-		return diag.Errorf("unable to set kubernetes `client_key` read value: %v", err)
+	if err := setKubeConfigAttributes(d, kubeConfig.KubeConfig); err != nil {
+		return diag.Errorf("error reading kubeconfig for kubernetes cluster %s : %v", k8List[0].ID, err)
 	}
 	if err := d.Set("node_pools", flattenNodePools(k8List[0].NodePools)); err != nil {
 		return diag.Errorf("unable to set kubernetes `node_pools` read value: %v", err)
@@ -224,21 +233,22 @@ func flattenNodePools(np []govultr.NodePool) []map[string]interface{} {
 		}
 
 		pool := map[string]interface{}{
-			"label":         n.Label,
-			"plan":          n.Plan,
-			"node_quantity": n.NodeQuantity,
-			"id":            n.ID,
-			"date_created":  n.DateCreated,
-			"date_updated":  n.DateUpdated,
-			"status":        n.Status,
-			"tag":           n.Tag,
-			"auto_scaler":   n.AutoScaler,
-			"min_nodes":     n.MinNodes,
-			"max_nodes":     n.MaxNodes,
-			"nodes":         instances,
-			"labels":        n.Labels,
-			"taints":        taints,
-			"user_data":     n.UserData,
+			"label":                 n.Label,
+			"plan":                  n.Plan,
+			"node_quantity":         n.NodeQuantity,
+			"current_node_quantity": len(n.Nodes),
+			"id":                    n.ID,
+			"date_created":          n.DateCreated,
+			"date_updated":          n.DateUpdated,
+			"status":                n.Status,
+			"tag":                   n.Tag,
+			"auto_scaler":           n.AutoScaler,
+			"min_nodes":             n.MinNodes,
+			"max_nodes":             n.MaxNodes,
+			"nodes":                 instances,
+			"labels":                n.Labels,
+			"taints":                taints,
+			"user_data":             n.UserData,
 		}
 
 		nodePools = append(nodePools, pool)
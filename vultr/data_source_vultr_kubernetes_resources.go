@@ -0,0 +1,119 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// govultr doesn't wrap the cluster resources endpoint, so it is called
+// directly through the authenticated client
+const vkeResourcesPath = "/v2/kubernetes/clusters/%s/resources"
+
+type vkeResourcesBase struct {
+	Resources vkeResources `json:"resources"`
+}
+
+type vkeResources struct {
+	BlockStorage []vkeResource `json:"block_storage"`
+	LoadBalancer []vkeResource `json:"load_balancer"`
+}
+
+type vkeResource struct {
+	ID          string `json:"id"`
+	Label       string `json:"label"`
+	DateCreated string `json:"date_created"`
+	Status      string `json:"status"`
+}
+
+func dataSourceVultrKubernetesResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrKubernetesResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"block_storage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: vkeResourceSchema(),
+				},
+			},
+			"load_balancers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: vkeResourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func vkeResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"label": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"date_created": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceVultrKubernetesResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	clusterID := d.Get("cluster_id").(string)
+
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(vkeResourcesPath, clusterID), nil)
+	if err != nil {
+		return diag.Errorf("error building kubernetes resources request: %v", err)
+	}
+
+	resources := new(vkeResourcesBase)
+	if _, err = client.DoWithContext(ctx, req, resources); err != nil {
+		return diag.Errorf("error getting resources for kubernetes cluster %s: %v", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+	if err := d.Set("block_storage", flattenVKEResources(resources.Resources.BlockStorage)); err != nil {
+		return diag.Errorf("unable to set kubernetes_resources `block_storage` read value: %v", err)
+	}
+	if err := d.Set("load_balancers", flattenVKEResources(resources.Resources.LoadBalancer)); err != nil {
+		return diag.Errorf("unable to set kubernetes_resources `load_balancers` read value: %v", err)
+	}
+
+	return nil
+}
+
+func flattenVKEResources(resources []vkeResource) []map[string]interface{} {
+	var list []map[string]interface{}
+	for _, r := range resources {
+		list = append(list, map[string]interface{}{
+			"id":           r.ID,
+			"label":        r.Label,
+			"date_created": r.DateCreated,
+			"status":       r.Status,
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,53 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrKubernetesResources(t *testing.T) {
+	skipCI(t)
+
+	rLabel := acctest.RandomWithPrefix("tf-test-k8")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVultrKubernetesResources(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.vultr_kubernetes_resources.test", "cluster_id",
+						"vultr_kubernetes.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.vultr_kubernetes_resources.test", "block_storage.#", "0"),
+					resource.TestCheckResourceAttr("data.vultr_kubernetes_resources.test", "load_balancers.#", "0"),
+					resource.TestCheckResourceAttr("vultr_kubernetes.test", "delete_linked_resources", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrKubernetesResources(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_kubernetes" "test" {
+			region = "ewr"
+			label = "%s"
+			version = "v1.26.2+2"
+			delete_linked_resources = true
+
+			node_pools {
+				node_quantity = 1
+				plan = "vc2-2c-4gb"
+				label = "tf-test-label"
+			}
+		}
+
+		data "vultr_kubernetes_resources" "test" {
+			cluster_id = vultr_kubernetes.test.id
+		}`, label)
+}
@@ -0,0 +1,65 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceVultrKubernetesUpgrades() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrKubernetesUpgradesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"available_upgrades": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"latest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVultrKubernetesUpgradesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	clusterID := d.Get("cluster_id").(string)
+
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, clusterID)
+	if err != nil {
+		return diag.Errorf("error getting available upgrades for kubernetes cluster %s: %v", clusterID, err)
+	}
+
+	// A cluster already on the newest version has no upgrades, which isn't
+	// an error; latest is left empty in that case
+	matched := filterVKEVersions(upgrades, d.Get("prefix").(string))
+
+	latest := ""
+	if len(matched) > 0 {
+		latest = matched[0]
+	}
+
+	d.SetId(clusterID)
+	if err := d.Set("available_upgrades", matched); err != nil {
+		return diag.Errorf("unable to set kubernetes_upgrades `available_upgrades` read value: %v", err)
+	}
+	if err := d.Set("latest", latest); err != nil {
+		return diag.Errorf("unable to set kubernetes_upgrades `latest` read value: %v", err)
+	}
+
+	return nil
+}
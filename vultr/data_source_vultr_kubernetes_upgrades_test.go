@@ -0,0 +1,52 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrKubernetesUpgrades(t *testing.T) {
+	skipCI(t)
+
+	rLabel := acctest.RandomWithPrefix("tf-test-k8")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVultrKubernetesUpgrades(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.vultr_kubernetes_upgrades.test", "cluster_id",
+						"vultr_kubernetes.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.vultr_kubernetes_upgrades.test", "available_upgrades.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrKubernetesUpgrades(label string) string {
+	return fmt.Sprintf(`
+		data "vultr_kubernetes_versions" "all" {}
+
+		resource "vultr_kubernetes" "test" {
+			region = "ewr"
+			label = "%s"
+			version = data.vultr_kubernetes_versions.all.versions[length(data.vultr_kubernetes_versions.all.versions) - 1]
+
+			node_pools {
+				node_quantity = 1
+				plan = "vc2-2c-4gb"
+				label = "tf-test-label"
+			}
+		}
+
+		data "vultr_kubernetes_upgrades" "test" {
+			cluster_id = vultr_kubernetes.test.id
+		}`, label)
+}
@@ -0,0 +1,54 @@
+package vultr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVultrKubernetesVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVultrKubernetesVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"latest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVultrKubernetesVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	versions, _, err := client.Kubernetes.GetVersions(ctx)
+	if err != nil {
+		return diag.Errorf("error getting kubernetes versions: %v", err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	matched := filterVKEVersions(versions.Versions, prefix)
+	if len(matched) < 1 {
+		return diag.Errorf("no kubernetes versions were found matching prefix %q", prefix)
+	}
+
+	d.SetId("kubernetes_versions")
+	if err := d.Set("versions", matched); err != nil {
+		return diag.Errorf("unable to set kubernetes_versions `versions` read value: %v", err)
+	}
+	if err := d.Set("latest", matched[0]); err != nil {
+		return diag.Errorf("unable to set kubernetes_versions `latest` read value: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package vultr
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrKubernetesVersions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVultrKubernetesVersions(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vultr_kubernetes_versions.all", "latest"),
+					resource.TestCheckResourceAttrSet("data.vultr_kubernetes_versions.all", "versions.#"),
+					resource.TestCheckResourceAttrPair(
+						"data.vultr_kubernetes_versions.minor", "latest",
+						"data.vultr_kubernetes_versions.minor", "versions.0",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrKubernetesVersions() string {
+	return `
+		data "vultr_kubernetes_versions" "all" {}
+
+		data "vultr_kubernetes_versions" "minor" {
+			prefix = join(".", slice(split(".", data.vultr_kubernetes_versions.all.latest), 0, 2))
+		}`
+}
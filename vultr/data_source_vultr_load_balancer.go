@@ -64,6 +64,31 @@ func dataSourceVultrLoadBalancer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_ssl": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"nodes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"global_regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"http2": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"http3": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"ipv4": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -168,6 +193,31 @@ func dataSourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("unable to set load_balancer `ipv6` read value: %v", err)
 	}
 
+	if err := d.Set("nodes", lbList[0].Nodes); err != nil {
+		return diag.Errorf("unable to set load_balancer `nodes` read value: %v", err)
+	}
+	if err := d.Set("global_regions", lbList[0].GlobalRegions); err != nil {
+		return diag.Errorf("unable to set load_balancer `global_regions` read value: %v", err)
+	}
+	if err := d.Set("http2", lbList[0].HTTP2 != nil && *lbList[0].HTTP2); err != nil {
+		return diag.Errorf("unable to set load_balancer `http2` read value: %v", err)
+	}
+	if err := d.Set("http3", lbList[0].HTTP3 != nil && *lbList[0].HTTP3); err != nil {
+		return diag.Errorf("unable to set load_balancer `http3` read value: %v", err)
+	}
+	if err := d.Set("timeout", lbList[0].GenericInfo.Timeout); err != nil {
+		return diag.Errorf("unable to set load_balancer `timeout` read value: %v", err)
+	}
+
+	autoSSL := map[string]interface{}{}
+	if lbList[0].AutoSSL != nil && lbList[0].AutoSSL.DomainZone != "" {
+		autoSSL["domain_zone"] = lbList[0].AutoSSL.DomainZone
+		autoSSL["domain_sub"] = lbList[0].AutoSSL.DomainSub
+	}
+	if err := d.Set("auto_ssl", autoSSL); err != nil {
+		return diag.Errorf("unable to set load_balancer `auto_ssl` read value: %v", err)
+	}
+
 	var rulesList []map[string]interface{}
 	for _, rules := range lbList[0].ForwardingRules {
 		rule := map[string]interface{}{
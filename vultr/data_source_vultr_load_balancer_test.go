@@ -22,6 +22,9 @@ func TestAccVultrLoadBalancer(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.vultr_load_balancer.lb", "status"),
 					resource.TestCheckResourceAttrSet("data.vultr_load_balancer.lb", "region"),
 					resource.TestCheckResourceAttrSet("data.vultr_load_balancer.lb", "label"),
+					resource.TestCheckResourceAttr("data.vultr_load_balancer.lb", "nodes", "1"),
+					resource.TestCheckResourceAttr("data.vultr_load_balancer.lb", "http2", "false"),
+					resource.TestCheckResourceAttrSet("data.vultr_load_balancer.lb", "timeout"),
 				),
 			},
 		},
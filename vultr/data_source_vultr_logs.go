@@ -2,33 +2,99 @@ package vultr
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// The logs API is not available in govultr, so the data source talks to the
+// endpoint directly through the authenticated client
+const logsPath = "/v2/logs"
+
+type logsBase struct {
+	Logs []logEntry `json:"logs"`
+	Meta *logsMeta  `json:"meta"`
+}
+
+type logEntry struct {
+	ResourceID   string                 `json:"resource_id"`
+	ResourceType string                 `json:"resource_type"`
+	LogLevel     string                 `json:"log_level"`
+	Message      string                 `json:"message"`
+	Timestamp    string                 `json:"timestamp"`
+	Metadata     map[string]interface{} `json:"metadata"`
+}
+
+type logsMeta struct {
+	ContinueTime    string `json:"continue_time"`
+	ReturnedCount   int    `json:"returned_count"`
+	UnreturnedCount int    `json:"unreturned_count"`
+	TotalCount      int    `json:"total_count"`
+}
+
 func dataSourceVultrLogs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVultrLogsRead,
 		Schema: map[string]*schema.Schema{
 			"filter": dataSourceFiltersSchema(),
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"debug", "info", "warning", "error", "critical"}, false),
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"logs": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"id": {
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_level": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"name": {
+						"resource_type": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"date_created": {
+						"resource_id": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -37,10 +103,100 @@ func dataSourceVultrLogs() *schema.Resource {
 }
 
 func dataSourceVultrLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Note: Log service is not yet implemented in the govultr SDK
-	// This data source is a placeholder for when the SDK adds support
-	_ = meta
-	_ = ctx
+	client := meta.(*Client).govultrClient()
+
+	var logList []map[string]interface{}
+	filters, filtersOk := d.GetOk("filter")
+	f := buildVultrDataSourceFilter(filters.(*schema.Set))
+
+	query := url.Values{}
+	query.Set("start_time", d.Get("start_time").(string))
+	query.Set("end_time", d.Get("end_time").(string))
+	for _, field := range []string{"log_level", "resource_type", "resource_id"} {
+		if v, ok := d.GetOk(field); ok {
+			query.Set(field, v.(string))
+		}
+	}
+
+	for {
+		req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s?%s", logsPath, query.Encode()), nil)
+		if err != nil {
+			return diag.Errorf("error building logs request: %v", err)
+		}
+
+		logs := new(logsBase)
+		if _, err = client.DoWithContext(ctx, req, logs); err != nil {
+			return diag.Errorf("error getting logs: %v", err)
+		}
+
+		for _, entry := range logs.Logs {
+			sm, err := structToMap(entry)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// If filters exist, check if this entry matches
+			if filtersOk && !filterLoop(f, sm) {
+				continue
+			}
+
+			metadata, err := flattenLogMetadata(entry.Metadata)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			logList = append(logList, map[string]interface{}{
+				"timestamp":     entry.Timestamp,
+				"log_level":     entry.LogLevel,
+				"resource_type": entry.ResourceType,
+				"resource_id":   entry.ResourceID,
+				"message":       entry.Message,
+				"metadata":      metadata,
+			})
+		}
+
+		// The logs API pages by time rather than cursor; continue_time is the
+		// start_time of the next page while entries remain unreturned
+		if logs.Meta == nil || logs.Meta.UnreturnedCount == 0 || logs.Meta.ContinueTime == "" {
+			break
+		}
+
+		if logs.Meta.ContinueTime == query.Get("start_time") {
+			return diag.Errorf("error getting logs: pagination did not advance past %s", logs.Meta.ContinueTime)
+		}
+
+		query.Set("start_time", logs.Meta.ContinueTime)
+	}
+
+	d.SetId(fmt.Sprintf("logs-%s-%s", d.Get("start_time").(string), d.Get("end_time").(string)))
+	if err := d.Set("logs", logList); err != nil {
+		return diag.Errorf("unable to set `logs` read value: %v", err)
+	}
+
+	return nil
+}
+
+// flattenLogMetadata converts the free-form metadata object on a log entry
+// into a string map. Values other than strings are JSON encoded, so nested
+// objects and numbers can be read back with jsondecode.
+func flattenLogMetadata(metadata map[string]interface{}) (map[string]interface{}, error) {
+	flat := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		if v == nil {
+			continue
+		}
+
+		switch val := v.(type) {
+		case string:
+			flat[k] = val
+		default:
+			encoded, err := json.Marshal(val)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding log metadata %q: %v", k, err)
+			}
+			flat[k] = string(encoded)
+		}
+	}
 
-	return diag.Errorf("Log service is not yet implemented in the govultr SDK. Please check the SDK documentation for updates.")
+	return flat, nil
 }
@@ -0,0 +1,135 @@
+package vultr
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceVultrLogs(t *testing.T) {
+	rDesc := acctest.RandomWithPrefix("tf-logs-ds")
+	startTime := time.Now().Add(-1 * time.Hour).UTC().Format(time.RFC3339)
+	endTime := time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVultrLogsConfig(rDesc, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vultr_logs.all", "start_time", startTime),
+					resource.TestCheckResourceAttr("data.vultr_logs.all", "end_time", endTime),
+					testAccCheckVultrLogsNotEmpty("data.vultr_logs.all"),
+					resource.TestCheckResourceAttr("data.vultr_logs.filtered", "log_level", "info"),
+				),
+			},
+			{
+				// The data sources are read again, by which time the creation
+				// of the VPC has been logged
+				Config: testAccDataSourceVultrLogsConfig(rDesc, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVultrLogsMatch("data.vultr_logs.filtered", "vultr_vpc.foo", "info"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckVultrLogsNotEmpty checks that the logs data source returned at
+// least one entry and that each entry has a timestamp and level
+func testAccCheckVultrLogsNotEmpty(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["logs.#"])
+		if err != nil {
+			return fmt.Errorf("invalid logs count: %v", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("expected log entries in the time window, got none")
+		}
+
+		for i := 0; i < count; i++ {
+			for _, attr := range []string{"timestamp", "log_level"} {
+				if rs.Primary.Attributes[fmt.Sprintf("logs.%d.%s", i, attr)] == "" {
+					return fmt.Errorf("log entry %d has no %s", i, attr)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckVultrLogsMatch checks that the logs data source returned at
+// least one entry and that every entry belongs to the given resource and has
+// the given level
+func testAccCheckVultrLogsMatch(n, resourceName, level string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["logs.#"])
+		if err != nil {
+			return fmt.Errorf("invalid logs count: %v", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("expected %s log entries for %s, got none", level, res.Primary.ID)
+		}
+
+		for i := 0; i < count; i++ {
+			if id := rs.Primary.Attributes[fmt.Sprintf("logs.%d.resource_id", i)]; id != res.Primary.ID {
+				return fmt.Errorf("log entry %d has resource_id %q, expected %q", i, id, res.Primary.ID)
+			}
+			if l := rs.Primary.Attributes[fmt.Sprintf("logs.%d.log_level", i)]; l != level {
+				return fmt.Errorf("log entry %d has log_level %q, expected %q", i, l, level)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourceVultrLogsConfig(description, startTime, endTime string) string {
+	return fmt.Sprintf(`
+		resource "vultr_vpc" "foo" {
+			region   = "ewr"
+			description = "%s"
+		}
+
+		data "vultr_logs" "all" {
+			start_time = "%s"
+			end_time = "%s"
+
+			depends_on = [vultr_vpc.foo]
+		}
+
+		data "vultr_logs" "filtered" {
+			start_time = "%s"
+			end_time = "%s"
+			log_level = "info"
+			resource_id = vultr_vpc.foo.id
+
+			filter {
+				name = "log_level"
+				values = ["info"]
+			}
+		}`, description, startTime, endTime, startTime, endTime)
+}
@@ -1,6 +1,8 @@
 package vultr
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,6 +12,32 @@ import (
 
 const defaultTimeout = 60 * time.Minute
 
+// databaseErrorStatus is the status of a managed database whose last
+// operation failed
+const databaseErrorStatus = "Error"
+
+// databaseStatusError returns the error for a managed database in the Error
+// status, including the most recent service alert as the failure detail
+func databaseStatusError(ctx context.Context, client *govultr.Client, databaseID string) error {
+	alerts, _, err := client.Database.ListServiceAlerts(ctx, databaseID, &govultr.DatabaseListAlertsReq{Period: "day"})
+	if err != nil || len(alerts) == 0 {
+		return fmt.Errorf("managed database %s is in the %s state", databaseID, databaseErrorStatus)
+	}
+
+	latest := alerts[0]
+	for _, alert := range alerts[1:] {
+		if alert.Timestamp > latest.Timestamp {
+			latest = alert
+		}
+	}
+
+	if latest.Recommendation != "" {
+		return fmt.Errorf("managed database %s is in the %s state: %s (%s)",
+			databaseID, databaseErrorStatus, latest.Description, latest.Recommendation)
+	}
+	return fmt.Errorf("managed database %s is in the %s state: %s", databaseID, databaseErrorStatus, latest.Description)
+}
+
 func readReplicaSchema(isReadReplica bool) map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		// Required
@@ -0,0 +1,244 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vultr/govultr/v3"
+)
+
+const databasePath = "/v2/databases"
+
+// databaseAdvancedOptions is the advanced options API response. The
+// configured options are kept as a map since govultr's struct drops zero
+// values and only covers some of the options.
+type databaseAdvancedOptions struct {
+	ConfiguredOptions map[string]interface{}    `json:"configured_options"`
+	AvailableOptions  []govultr.AvailableOption `json:"available_options"`
+}
+
+func getDatabaseAdvancedOptions(ctx context.Context, client *govultr.Client, databaseID string) (*databaseAdvancedOptions, error) { //nolint:lll
+	uri := fmt.Sprintf("%s/%s/advanced-options", databasePath, databaseID)
+	req, err := client.NewRequest(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	options := new(databaseAdvancedOptions)
+	if _, err := client.DoWithContext(ctx, req, options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// updateDatabaseAdvancedOptions validates the configured advanced options
+// against the ones available for the database and applies them. The options
+// in reset are sent as null, which returns them to their defaults.
+func updateDatabaseAdvancedOptions(ctx context.Context, client *govultr.Client, databaseID string, values map[string]interface{}, reset []string) error { //nolint:lll
+	current, err := getDatabaseAdvancedOptions(ctx, client, databaseID)
+	if err != nil {
+		return fmt.Errorf("error getting available advanced options: %v", err)
+	}
+
+	body, err := expandDatabaseAdvancedOptions(values, current.AvailableOptions)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range reset {
+		if _, ok := body[name]; !ok {
+			body[name] = nil
+		}
+	}
+
+	uri := fmt.Sprintf("%s/%s/advanced-options", databasePath, databaseID)
+	req, err := client.NewRequest(ctx, http.MethodPut, uri, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoWithContext(ctx, req, nil)
+	return err
+}
+
+// expandDatabaseAdvancedOptions converts the string values of the
+// advanced_options map to the types of the available options, checking them
+// against the allowed values
+func expandDatabaseAdvancedOptions(values map[string]interface{}, available []govultr.AvailableOption) (map[string]interface{}, error) { //nolint:lll
+	byName := make(map[string]govultr.AvailableOption, len(available))
+	for i := range available {
+		byName[available[i].Name] = available[i]
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	body := make(map[string]interface{}, len(values))
+	for _, name := range names {
+		option, ok := byName[name]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%q is not an available advanced option for this database", name))
+			continue
+		}
+
+		value, err := convertDatabaseAdvancedOption(option, values[name].(string))
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		body[name] = value
+	}
+
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("invalid advanced_options:\n  %s", strings.Join(errs, "\n  "))
+	}
+
+	return body, nil
+}
+
+func convertDatabaseAdvancedOption(option govultr.AvailableOption, value string) (interface{}, error) {
+	if len(option.Enumerals) != 0 {
+		for _, e := range option.Enumerals {
+			if e == value {
+				return value, nil
+			}
+		}
+		return nil, fmt.Errorf("%q must be one of %s, got %q", option.Name, strings.Join(option.Enumerals, ", "), value)
+	}
+
+	switch strings.ToLower(option.Type) {
+	case "bool", "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q must be a boolean, got %q", option.Name, value)
+		}
+		return b, nil
+	case "int", "integer":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q must be an integer, got %q", option.Name, value)
+		}
+		for _, alt := range option.AltValues {
+			if int64(alt) == i {
+				return i, nil
+			}
+		}
+		if err := checkDatabaseAdvancedOptionRange(option, float64(i)); err != nil {
+			return nil, err
+		}
+		return i, nil
+	case "float", "number", "double":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q must be a number, got %q", option.Name, value)
+		}
+		if err := checkDatabaseAdvancedOptionRange(option, f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	default:
+		return value, nil
+	}
+}
+
+func checkDatabaseAdvancedOptionRange(option govultr.AvailableOption, value float64) error {
+	if option.MinValue != nil && value < float64(*option.MinValue) {
+		return fmt.Errorf("%q must be at least %s, got %s", option.Name, formatAdvancedOptionNumber(*option.MinValue),
+			strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	if option.MaxValue != nil && value > float64(*option.MaxValue) {
+		return fmt.Errorf("%q must be at most %s, got %s", option.Name, formatAdvancedOptionNumber(*option.MaxValue),
+			strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	return nil
+}
+
+func formatAdvancedOptionNumber(v float32) string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+// flattenDatabaseAdvancedOptions returns the configured value of each option
+// in names as a string
+func flattenDatabaseAdvancedOptions(configured map[string]interface{}, names []string) map[string]string {
+	options := make(map[string]string, len(names))
+	for _, name := range names {
+		value, ok := configured[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		switch v := value.(type) {
+		case float64:
+			if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+				options[name] = strconv.FormatInt(int64(v), 10)
+			} else {
+				options[name] = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		case bool:
+			options[name] = strconv.FormatBool(v)
+		default:
+			options[name] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return options
+}
+
+// removedDatabaseAdvancedOptions returns the options in old which are no
+// longer in new
+func removedDatabaseAdvancedOptions(old, new map[string]interface{}) []string {
+	var removed []string
+	for name := range old {
+		if _, ok := new[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+
+	return removed
+}
+
+// suppressAdvancedOptionNumberDiff ignores differences in how equal numbers
+// are written, e.g. "0.10" and "0.1"
+func suppressAdvancedOptionNumberDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	o, errOld := strconv.ParseFloat(old, 64)
+	n, errNew := strconv.ParseFloat(new, 64)
+	return errOld == nil && errNew == nil && o == n
+}
+
+// resourceVultrDatabaseAdvancedOptionsDiff validates changed advanced options
+// of existing databases at plan time. The available options of new databases
+// aren't known until they are created, so those are validated on apply.
+func resourceVultrDatabaseAdvancedOptionsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("advanced_options") || !d.NewValueKnown("advanced_options") {
+		return nil
+	}
+
+	values := d.Get("advanced_options").(map[string]interface{})
+	if len(values) == 0 {
+		return nil
+	}
+
+	options, err := getDatabaseAdvancedOptions(ctx, meta.(*Client).govultrClient(), d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting available advanced options for database %s: %v", d.Id(), err)
+	}
+
+	_, err = expandDatabaseAdvancedOptions(values, options.AvailableOptions)
+	return err
+}
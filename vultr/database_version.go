@@ -0,0 +1,128 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// databaseUpgradingStatus is the pseudo status used while a managed database
+// hasn't reached its new engine version yet
+const databaseUpgradingStatus = "Upgrading"
+
+// compareDatabaseVersions compares two engine versions such as "15" and
+// "16.1" part by part, returning -1, 0 or 1
+func compareDatabaseVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart string
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+
+		aNum, aErr := strconv.Atoi(aPart)
+		bNum, bErr := strconv.Atoi(bPart)
+		switch {
+		case aErr == nil && bErr == nil && aNum != bNum:
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && aPart != bPart:
+			if aPart < bPart {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// resourceVultrDatabaseVersionDiff rejects downgrades and versions that
+// aren't available as an upgrade for the managed database. Changes to
+// database_engine or restore_from replace the database, so the new version
+// isn't an upgrade and is left to the API to check.
+func resourceVultrDatabaseVersionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("database_engine_version") || !d.NewValueKnown("database_engine_version") {
+		return nil
+	}
+
+	if d.HasChange("database_engine") || d.HasChange("restore_from") {
+		return nil
+	}
+
+	oldVal, newVal := d.GetChange("database_engine_version")
+	oldVersion, newVersion := oldVal.(string), newVal.(string)
+	if compareDatabaseVersions(newVersion, oldVersion) < 0 {
+		return fmt.Errorf("database_engine_version cannot be downgraded from %s to %s", oldVersion, newVersion)
+	}
+
+	availableVersions, _, err := meta.(*Client).govultrClient().Database.ListAvailableVersions(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("error checking available version upgrades for database %s : %v", d.Id(), err)
+	}
+
+	if !versionCompare(availableVersions, newVersion) {
+		if len(availableVersions) == 0 {
+			return fmt.Errorf("database_engine_version %s is not available for database %s, no upgrades are available",
+				newVersion, d.Id())
+		}
+		return fmt.Errorf("database_engine_version %s is not available for database %s, available upgrades: %s",
+			newVersion, d.Id(), strings.Join(availableVersions, ", "))
+	}
+
+	return nil
+}
+
+func waitForDatabaseVersionUpgrade(ctx context.Context, d *schema.ResourceData, version string, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for Managed Database (%s) to upgrade to version %s", d.Id(), version)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{databaseUpgradingStatus, "Rebalancing", "Rebuilding", "Configuring"},
+		Target:                    []string{"Running"},
+		Refresh:                   newDatabaseVersionUpgradeRefresh(ctx, d, version, meta),
+		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Delay:                     10 * time.Second,
+		MinTimeout:                3 * time.Second,
+		NotFoundChecks:            60,
+		ContinuousTargetOccurence: 2,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func newDatabaseVersionUpgradeRefresh(ctx context.Context, d *schema.ResourceData, version string, meta interface{}) retry.StateRefreshFunc { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	start := time.Now()
+	return func() (interface{}, string, error) {
+		database, _, err := client.Database.Get(ctx, d.Id())
+		if err != nil {
+			return nil, "", fmt.Errorf("error retrieving Managed Database %s : %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] Managed Database %s upgrade to version %s: status %s, version %s, %s elapsed",
+			d.Id(), version, database.Status, database.DatabaseEngineVersion, time.Since(start).Round(time.Second))
+
+		if database.Status == databaseErrorStatus {
+			return database, database.Status, databaseStatusError(ctx, client, d.Id())
+		}
+
+		if database.Status == "Running" && database.DatabaseEngineVersion != version {
+			return database, databaseUpgradingStatus, nil
+		}
+
+		return database, database.Status, nil
+	}
+}
@@ -1,12 +1,19 @@
 package vultr
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vultr/govultr/v3"
 )
 
+const userDataHashPrefix = "sha256:"
+
 func getVPCs(client *govultr.Client, instanceID string) ([]string, error) {
 	options := &govultr.ListOptions{}
 	var vpcs []string
@@ -71,3 +78,46 @@ func getBareMetalServerVPC2s(client *govultr.Client, serverID string) ([]string,
 
 	return vpcs, nil
 }
+
+// decodeUserData returns the user data the API reports for a server. The API
+// hands it back base64 encoded, but fall back to the raw value if it isn't.
+// Gzip payloads stay encoded, matching how they are configured.
+func decodeUserData(userData *govultr.UserData) string {
+	if userData == nil || userData.Data == "" {
+		return ""
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(userData.Data)
+	if err != nil || bytes.HasPrefix(decoded, gzipMagic) {
+		return userData.Data
+	}
+
+	return string(decoded)
+}
+
+// userDataHash returns the value stored in state for user_data when
+// user_data_hash_only is enabled
+func userDataHash(userData string) string {
+	if userData == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(userData))
+	return userDataHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// userDataStateValue returns the user_data value to store in state, which is
+// either the user data itself or its hash depending on user_data_hash_only
+func userDataStateValue(d *schema.ResourceData, userData string) string {
+	if d.Get("user_data_hash_only").(bool) {
+		return userDataHash(userData)
+	}
+
+	return userData
+}
+
+// suppressUserDataHashDiff implements a DiffSuppressFunc that ignores
+// user_data when the value in state is the hash of the configured value
+func suppressUserDataHashDiff(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && old == userDataHash(new)
+}
@@ -0,0 +1,195 @@
+package vultr
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lbCertExpiryWarning is how close to expiry a load balancer certificate can
+// get before validation starts warning about it
+const lbCertExpiryWarning = 30 * 24 * time.Hour
+
+// lbCertificateInfo is the metadata exported for a load balancer certificate
+type lbCertificateInfo struct {
+	Subject  string
+	SANs     []string
+	NotAfter string
+}
+
+// parsePEMCertificates decodes every PEM block in data as an x509 certificate
+func parsePEMCertificates(data string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("unexpected PEM block of type %q, only CERTIFICATE blocks are allowed", block.Type)
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate %d: %v", len(certs)+1, err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	return certs, nil
+}
+
+// validateLBCertificatePEM is a ValidateFunc for PEM certificates which warns
+// about certificates that have expired or are about to
+func validateLBCertificatePEM(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if value == "" {
+		return nil, nil
+	}
+
+	certs, err := parsePEMCertificates(value)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q is not a valid PEM certificate: %v", k, err)}
+	}
+
+	now := time.Now()
+	for _, cert := range certs {
+		switch {
+		case now.After(cert.NotAfter):
+			ws = append(ws, fmt.Sprintf("%q contains a certificate for %q which expired on %s",
+				k, cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339)))
+		case now.Add(lbCertExpiryWarning).After(cert.NotAfter):
+			ws = append(ws, fmt.Sprintf("%q contains a certificate for %q which expires on %s",
+				k, cert.Subject.String(), cert.NotAfter.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	return ws, nil
+}
+
+// validateLBPrivateKeyPEM is a ValidateFunc for PEM private keys. The key is
+// never included in the error.
+func validateLBPrivateKeyPEM(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+	if value == "" {
+		return nil, nil
+	}
+
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return nil, []error{fmt.Errorf("%q is not a PEM encoded private key", k)}
+	}
+
+	if _, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return nil, nil
+	}
+	if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return nil, nil
+	}
+	if _, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return nil, nil
+	}
+
+	return nil, []error{fmt.Errorf("%q is not a supported private key, expected a PKCS#1, PKCS#8 or EC key", k)}
+}
+
+// checkLBCertificate verifies that the certificate is a single certificate
+// matching the private key, and that the chain is ordered from the issuer of
+// the certificate towards the root. It returns the certificate metadata.
+func checkLBCertificate(certificate, privateKey, chain string) (*lbCertificateInfo, error) {
+	certs, err := parsePEMCertificates(certificate)
+	if err != nil {
+		return nil, fmt.Errorf("ssl `certificate` is invalid: %v", err)
+	}
+	if len(certs) != 1 {
+		return nil, fmt.Errorf("ssl `certificate` must contain a single certificate, move intermediates to `chain`")
+	}
+
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		return nil, fmt.Errorf("ssl `private_key` does not match `certificate`: %v", err)
+	}
+
+	if chain != "" {
+		chainCerts, err := parsePEMCertificates(chain)
+		if err != nil {
+			return nil, fmt.Errorf("ssl `chain` is invalid: %v", err)
+		}
+
+		ordered := append(certs, chainCerts...)
+		for i := 0; i < len(ordered)-1; i++ {
+			if err := ordered[i].CheckSignatureFrom(ordered[i+1]); err != nil {
+				return nil, fmt.Errorf("ssl `chain` is out of order: %q is not issued by %q",
+					ordered[i].Subject.String(), ordered[i+1].Subject.String())
+			}
+		}
+	}
+
+	leaf := certs[0]
+	info := &lbCertificateInfo{
+		Subject:  leaf.Subject.String(),
+		SANs:     append([]string{}, leaf.DNSNames...),
+		NotAfter: leaf.NotAfter.UTC().Format(time.RFC3339),
+	}
+	for _, ip := range leaf.IPAddresses {
+		info.SANs = append(info.SANs, ip.String())
+	}
+
+	return info, nil
+}
+
+// lbSSLFromConfig returns the certificate, private key and chain of the ssl
+// block in the raw config, and whether they are all known
+func lbSSLFromConfig(d *schema.ResourceDiff) (certificate, privateKey, chain string, ok bool) {
+	raw := d.GetRawConfig().GetAttr("ssl")
+	if raw.IsNull() || !raw.IsKnown() || raw.LengthInt() == 0 {
+		return "", "", "", false
+	}
+
+	ssl := raw.AsValueSlice()[0]
+	values := make([]string, 0, 3) //nolint:mnd
+	for _, attr := range []string{"certificate", "private_key", "chain"} {
+		v := ssl.GetAttr(attr)
+		if !v.IsKnown() {
+			return "", "", "", false
+		}
+		if v.IsNull() {
+			values = append(values, "")
+			continue
+		}
+		values = append(values, v.AsString())
+	}
+
+	return values[0], values[1], values[2], true
+}
+
+// setLBCertificateInfo sets the computed certificate metadata of a load
+// balancer, clearing it when info is nil
+func setLBCertificateInfo(d *schema.ResourceData, info *lbCertificateInfo) error {
+	if info == nil {
+		info = &lbCertificateInfo{}
+	}
+
+	if err := d.Set("ssl_certificate_subject", info.Subject); err != nil {
+		return fmt.Errorf("unable to set resource load_balancer `ssl_certificate_subject` read value: %v", err)
+	}
+	if err := d.Set("ssl_certificate_sans", info.SANs); err != nil {
+		return fmt.Errorf("unable to set resource load_balancer `ssl_certificate_sans` read value: %v", err)
+	}
+	if err := d.Set("ssl_certificate_not_after", info.NotAfter); err != nil {
+		return fmt.Errorf("unable to set resource load_balancer `ssl_certificate_not_after` read value: %v", err)
+	}
+
+	return nil
+}
@@ -27,89 +27,106 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"vultr_account":                     dataSourceVultrAccount(),
-			"vultr_application":                 dataSourceVultrApplication(),
-			"vultr_backup":                      dataSourceVultrBackup(),
-			"vultr_bare_metal_plan":             dataSourceVultrBareMetalPlan(),
-			"vultr_bare_metal_server":           dataSourceVultrBareMetalServer(),
-			"vultr_billing_history":             dataSourceVultrBillingHistory(),
-			"vultr_block_storage":               dataSourceVultrBlockStorage(),
-			"vultr_cdn_pull_zone":               dataSourceVultrCDNPullZone(),
-			"vultr_cdn_pull_zones":              dataSourceVultrCDNPullZones(),
-			"vultr_cdn_push_zone":               dataSourceVultrCDNPushZone(),
-			"vultr_cdn_push_zones":              dataSourceVultrCDNPushZones(),
-			"vultr_container_registry":          dataSourceVultrContainerRegistry(),
-			"vultr_database":                    dataSourceVultrDatabase(),
-			"vultr_dns_domain":                  dataSourceVultrDNSDomain(),
-			"vultr_firewall_group":              dataSourceVultrFirewallGroup(),
-			"vultr_inference":                   dataSourceVultrInference(),
-			"vultr_invoice":                     dataSourceVultrInvoice(),
-			"vultr_invoice_items":               dataSourceVultrInvoiceItems(),
-			"vultr_invoices":                    dataSourceVultrInvoices(),
-			"vultr_iso_private":                 dataSourceVultrIsoPrivate(),
-			"vultr_iso_public":                  dataSourceVultrIsoPublic(),
-			"vultr_kubernetes":                  dataSourceVultrKubernetes(),
-			"vultr_load_balancer":               dataSourceVultrLoadBalancer(),
-			"vultr_logs":                        dataSourceVultrLogs(),
-			"vultr_object_storage":              dataSourceVultrObjectStorage(),
-			"vultr_object_storage_cluster":      dataSourceVultrObjectStorageClusters(),
-			"vultr_object_storage_tier":         dataSourceVultrObjectStorageTier(),
-			"vultr_os":                          dataSourceVultrOS(),
-			"vultr_pending_charges":             dataSourceVultrPendingCharges(),
-			"vultr_plan":                        dataSourceVultrPlan(),
-			"vultr_region":                      dataSourceVultrRegion(),
-			"vultr_reserved_ip":                 dataSourceVultrReservedIP(),
-			"vultr_reverse_ipv4":                dataSourceVultrReverseIPV4(),
-			"vultr_reverse_ipv6":                dataSourceVultrReverseIPV6(),
-			"vultr_instance":                    dataSourceVultrInstance(),
-			"vultr_instances":                   dataSourceVultrInstances(),
-			"vultr_instance_ipv4":               dataSourceVultrInstanceIPV4(),
-			"vultr_snapshot":                    dataSourceVultrSnapshot(),
-			"vultr_ssh_key":                     dataSourceVultrSSHKey(),
-			"vultr_startup_script":              dataSourceVultrStartupScript(),
-			"vultr_user":                        dataSourceVultrUser(),
-			"vultr_virtual_file_system_storage": dataSourceVultrVirtualFileSystemStorage(),
-			"vultr_vpc":                         dataSourceVultrVPC(),
-			"vultr_vpc2":                        dataSourceVultrVPC2(),
+			"vultr_account":                       dataSourceVultrAccount(),
+			"vultr_application":                   dataSourceVultrApplication(),
+			"vultr_backup":                        dataSourceVultrBackup(),
+			"vultr_bare_metal_plan":               dataSourceVultrBareMetalPlan(),
+			"vultr_bare_metal_server":             dataSourceVultrBareMetalServer(),
+			"vultr_billing_history":               dataSourceVultrBillingHistory(),
+			"vultr_block_storage":                 dataSourceVultrBlockStorage(),
+			"vultr_cdn_pull_zone":                 dataSourceVultrCDNPullZone(),
+			"vultr_cdn_pull_zones":                dataSourceVultrCDNPullZones(),
+			"vultr_cdn_push_zone":                 dataSourceVultrCDNPushZone(),
+			"vultr_cdn_push_zones":                dataSourceVultrCDNPushZones(),
+			"vultr_cloudinit_config":              dataSourceVultrCloudinitConfig(),
+			"vultr_container_registry":            dataSourceVultrContainerRegistry(),
+			"vultr_database":                      dataSourceVultrDatabase(),
+			"vultr_database_alerts":               dataSourceVultrDatabaseAlerts(),
+			"vultr_database_available_connectors": dataSourceVultrDatabaseAvailableConnectors(),
+			"vultr_database_backups":              dataSourceVultrDatabaseBackups(),
+			"vultr_database_plan":                 dataSourceVultrDatabasePlan(),
+			"vultr_database_plans":                dataSourceVultrDatabasePlans(),
+			"vultr_database_usage":                dataSourceVultrDatabaseUsage(),
+			"vultr_dns_domain":                    dataSourceVultrDNSDomain(),
+			"vultr_firewall_group":                dataSourceVultrFirewallGroup(),
+			"vultr_inference":                     dataSourceVultrInference(),
+			"vultr_invoice":                       dataSourceVultrInvoice(),
+			"vultr_invoice_items":                 dataSourceVultrInvoiceItems(),
+			"vultr_invoices":                      dataSourceVultrInvoices(),
+			"vultr_iso_private":                   dataSourceVultrIsoPrivate(),
+			"vultr_iso_public":                    dataSourceVultrIsoPublic(),
+			"vultr_kubernetes":                    dataSourceVultrKubernetes(),
+			"vultr_kubernetes_resources":          dataSourceVultrKubernetesResources(),
+			"vultr_kubernetes_upgrades":           dataSourceVultrKubernetesUpgrades(),
+			"vultr_kubernetes_versions":           dataSourceVultrKubernetesVersions(),
+			"vultr_load_balancer":                 dataSourceVultrLoadBalancer(),
+			"vultr_logs":                          dataSourceVultrLogs(),
+			"vultr_object_storage":                dataSourceVultrObjectStorage(),
+			"vultr_object_storage_cluster":        dataSourceVultrObjectStorageClusters(),
+			"vultr_object_storage_tier":           dataSourceVultrObjectStorageTier(),
+			"vultr_os":                            dataSourceVultrOS(),
+			"vultr_pending_charges":               dataSourceVultrPendingCharges(),
+			"vultr_plan":                          dataSourceVultrPlan(),
+			"vultr_region":                        dataSourceVultrRegion(),
+			"vultr_reserved_ip":                   dataSourceVultrReservedIP(),
+			"vultr_reverse_ipv4":                  dataSourceVultrReverseIPV4(),
+			"vultr_reverse_ipv6":                  dataSourceVultrReverseIPV6(),
+			"vultr_instance":                      dataSourceVultrInstance(),
+			"vultr_instances":                     dataSourceVultrInstances(),
+			"vultr_instance_ipv4":                 dataSourceVultrInstanceIPV4(),
+			"vultr_snapshot":                      dataSourceVultrSnapshot(),
+			"vultr_ssh_key":                       dataSourceVultrSSHKey(),
+			"vultr_startup_script":                dataSourceVultrStartupScript(),
+			"vultr_user":                          dataSourceVultrUser(),
+			"vultr_virtual_file_system_storage":   dataSourceVultrVirtualFileSystemStorage(),
+			"vultr_vpc":                           dataSourceVultrVPC(),
+			"vultr_vpc2":                          dataSourceVultrVPC2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"vultr_bare_metal_server":           resourceVultrBareMetalServer(),
-			"vultr_block_storage":               resourceVultrBlockStorage(),
-			"vultr_cdn_pull_zone":               resourceVultrCDNPullZone(),
-			"vultr_cdn_push_zone":               resourceVultrCDNPushZone(),
-			"vultr_container_registry":          resourceVultrContainerRegistry(),
-			"vultr_database":                    resourceVultrDatabase(),
-			"vultr_database_connection_pool":    resourceVultrDatabaseConnectionPool(),
-			"vultr_database_db":                 resourceVultrDatabaseDB(),
-			"vultr_database_replica":            resourceVultrDatabaseReplica(),
-			"vultr_database_user":               resourceVultrDatabaseUser(),
-			"vultr_database_topic":              resourceVultrDatabaseTopic(),
-			"vultr_database_quota":              resourceVultrDatabaseQuota(),
-			"vultr_database_connector":          resourceVultrDatabaseConnector(),
-			"vultr_dns_domain":                  resourceVultrDNSDomain(),
-			"vultr_dns_record":                  resourceVultrDNSRecord(),
-			"vultr_firewall_group":              resourceVultrFirewallGroup(),
-			"vultr_firewall_rule":               resourceVultrFirewallRule(),
-			"vultr_inference":                   resourceVultrInference(),
-			"vultr_iso_private":                 resourceVultrIsoPrivate(),
-			"vultr_kubernetes":                  resourceVultrKubernetes(),
-			"vultr_kubernetes_node_pools":       resourceVultrKubernetesNodePools(),
-			"vultr_load_balancer":               resourceVultrLoadBalancer(),
-			"vultr_object_storage":              resourceVultrObjectStorage(),
-			"vultr_reserved_ip":                 resourceVultrReservedIP(),
-			"vultr_reverse_ipv4":                resourceVultrReverseIPV4(),
-			"vultr_reverse_ipv6":                resourceVultrReverseIPV6(),
-			"vultr_snapshot":                    resourceVultrSnapshot(),
-			"vultr_snapshot_from_url":           resourceVultrSnapshotFromURL(),
-			"vultr_instance":                    resourceVultrInstance(),
-			"vultr_instance_ipv4":               resourceVultrInstanceIPV4(),
-			"vultr_ssh_key":                     resourceVultrSSHKey(),
-			"vultr_startup_script":              resourceVultrStartupScript(),
-			"vultr_user":                        resourceVultrUsers(),
-			"vultr_virtual_file_system_storage": resourceVultrVirtualFileSystemStorage(),
-			"vultr_vpc":                         resourceVultrVPC(),
-			"vultr_vpc2":                        resourceVultrVPC2(),
+			"vultr_bare_metal_server":             resourceVultrBareMetalServer(),
+			"vultr_block_storage":                 resourceVultrBlockStorage(),
+			"vultr_cdn_pull_zone":                 resourceVultrCDNPullZone(),
+			"vultr_cdn_push_zone":                 resourceVultrCDNPushZone(),
+			"vultr_container_registry":            resourceVultrContainerRegistry(),
+			"vultr_database":                      resourceVultrDatabase(),
+			"vultr_database_connection_pool":      resourceVultrDatabaseConnectionPool(),
+			"vultr_database_db":                   resourceVultrDatabaseDB(),
+			"vultr_database_migration":            resourceVultrDatabaseMigration(),
+			"vultr_database_replica":              resourceVultrDatabaseReplica(),
+			"vultr_database_replica_promotion":    resourceVultrDatabaseReplicaPromotion(),
+			"vultr_database_user":                 resourceVultrDatabaseUser(),
+			"vultr_database_topic":                resourceVultrDatabaseTopic(),
+			"vultr_database_quota":                resourceVultrDatabaseQuota(),
+			"vultr_database_connector":            resourceVultrDatabaseConnector(),
+			"vultr_dns_domain":                    resourceVultrDNSDomain(),
+			"vultr_dns_record":                    resourceVultrDNSRecord(),
+			"vultr_firewall_group":                resourceVultrFirewallGroup(),
+			"vultr_firewall_rule":                 resourceVultrFirewallRule(),
+			"vultr_inference":                     resourceVultrInference(),
+			"vultr_iso_private":                   resourceVultrIsoPrivate(),
+			"vultr_kubernetes":                    resourceVultrKubernetes(),
+			"vultr_kubernetes_node_pools":         resourceVultrKubernetesNodePools(),
+			"vultr_load_balancer":                 resourceVultrLoadBalancer(),
+			"vultr_load_balancer_attachment":      resourceVultrLoadBalancerAttachment(),
+			"vultr_load_balancer_firewall_rule":   resourceVultrLoadBalancerFirewallRule(),
+			"vultr_load_balancer_forwarding_rule": resourceVultrLoadBalancerForwardingRule(),
+			"vultr_object_storage":                resourceVultrObjectStorage(),
+			"vultr_reserved_ip":                   resourceVultrReservedIP(),
+			"vultr_reverse_ipv4":                  resourceVultrReverseIPV4(),
+			"vultr_reverse_ipv6":                  resourceVultrReverseIPV6(),
+			"vultr_snapshot":                      resourceVultrSnapshot(),
+			"vultr_snapshot_from_url":             resourceVultrSnapshotFromURL(),
+			"vultr_instance":                      resourceVultrInstance(),
+			"vultr_instance_backup_schedule":      resourceVultrInstanceBackupSchedule(),
+			"vultr_instance_ipv4":                 resourceVultrInstanceIPV4(),
+			"vultr_instance_vpc_attachment":       resourceVultrInstanceVPCAttachment(),
+			"vultr_ssh_key":                       resourceVultrSSHKey(),
+			"vultr_startup_script":                resourceVultrStartupScript(),
+			"vultr_user":                          resourceVultrUsers(),
+			"vultr_virtual_file_system_storage":   resourceVultrVirtualFileSystemStorage(),
+			"vultr_vpc":                           resourceVultrVPC(),
+			"vultr_vpc2":                          resourceVultrVPC2(),
 		},
 
 		ConfigureFunc: providerConfigure,
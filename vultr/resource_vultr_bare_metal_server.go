@@ -2,7 +2,6 @@ package vultr
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
@@ -74,6 +73,7 @@ func resourceVultrBareMetalServer() *schema.Resource {
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"vpc2_ids": {
 				Type:       schema.TypeSet,
@@ -89,10 +89,18 @@ func resourceVultrBareMetalServer() *schema.Resource {
 				Default:  nil,
 			},
 			"user_data": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressUserDataHashDiff,
+				ValidateFunc:     validateUserData(false),
+			},
+			"user_data_hash_only": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Default:  false,
+				Description: `Store only a SHA-256 hash of user_data in state
+rather than the user data itself. Useful for large cloud-init payloads.`,
 			},
 			"activation_email": {
 				Type:     schema.TypeBool,
@@ -234,7 +242,7 @@ func resourceVultrBareMetalServerCreate(ctx context.Context, d *schema.ResourceD
 		EnableIPv6:      govultr.BoolToBoolPtr(d.Get("enable_ipv6").(bool)),
 		Label:           d.Get("label").(string),
 		SSHKeyIDs:       keyIDs,
-		UserData:        base64.StdEncoding.EncodeToString([]byte(d.Get("user_data").(string))),
+		UserData:        encodeUserData(d.Get("user_data").(string)),
 		ActivationEmail: govultr.BoolToBoolPtr(d.Get("activation_email").(bool)),
 		Hostname:        d.Get("hostname").(string),
 		ReservedIPv4:    d.Get("reserved_ipv4").(string),
@@ -403,19 +411,32 @@ func resourceVultrBareMetalServerRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("unable to set resource bare_metal_server `user_scheme` read value: %v", err)
 	}
 
-	vpcInfo, _, err := client.BareMetalServer.ListVPCInfo(ctx, d.Id())
+	userData, _, err := client.BareMetalServer.GetUserData(ctx, d.Id())
 	if err != nil {
-		return diag.Errorf("error getting list of attached vpcs during bare metal server read : %v", err)
+		return diag.Errorf("error getting user data for bare metal server (%s): %v", d.Id(), err)
 	}
 
-	// only one VPC ever allowed on bare metal server
-	var vpcID = ""
-	if len(vpcInfo) != 0 {
-		vpcID = vpcInfo[0].ID
+	if err := d.Set("user_data", userDataStateValue(d, decodeUserData(userData))); err != nil {
+		return diag.Errorf("unable to set resource bare_metal_server `user_data` read value: %v", err)
 	}
 
-	if err := d.Set("vpc_id", vpcID); err != nil {
-		return diag.Errorf("unable to set resource bare metal server `vpc_id` read value : %v", err)
+	// vpc_id is only managed here when it is configured, so that attachments
+	// made through vultr_instance_vpc_attachment don't show up as drift
+	if _, vpcUpdate := d.GetOk("vpc_id"); vpcUpdate {
+		vpcInfo, _, err := client.BareMetalServer.ListVPCInfo(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting list of attached vpcs during bare metal server read : %v", err)
+		}
+
+		// only one VPC ever allowed on bare metal server
+		var vpcID = ""
+		if len(vpcInfo) != 0 {
+			vpcID = vpcInfo[0].ID
+		}
+
+		if err := d.Set("vpc_id", vpcID); err != nil {
+			return diag.Errorf("unable to set resource bare metal server `vpc_id` read value : %v", err)
+		}
 	}
 
 	vpc2s, err := getBareMetalServerVPC2s(client, d.Id())
@@ -532,6 +553,12 @@ func resourceVultrBareMetalServerUpdate(ctx context.Context, d *schema.ResourceD
 		req.UserScheme = uScheme
 	}
 
+	if d.HasChange("user_data") {
+		log.Printf(`[INFO] Updating bare metal server (%s) user data`, d.Id())
+		_, newVal := d.GetChange("user_data")
+		req.UserData = encodeUserData(newVal.(string))
+	}
+
 	if _, _, err := client.BareMetalServer.Update(ctx, d.Id(), req); err != nil {
 		return diag.Errorf("error updating bare metal %s : %s", d.Id(), err.Error())
 	}
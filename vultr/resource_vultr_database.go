@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +24,11 @@ func resourceVultrDatabase() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			resourceVultrDatabaseVersionDiff,
+			resourceVultrDatabaseAdvancedOptionsDiff,
+			resourceVultrDatabaseRestoreDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -126,6 +132,46 @@ func resourceVultrDatabase() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restore_from": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"backup_label": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_from.0.point_in_time"},
+							ValidateFunc:  validation.StringInSlice([]string{"latest", "oldest"}, false),
+						},
+						"point_in_time": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_from.0.backup_label"},
+							ValidateFunc:  validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+			"advanced_options": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressAdvancedOptionNumberDiff,
+			},
+			"apply_maintenance_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			// Computed
 			"date_created": {
 				Type:     schema.TypeString,
@@ -296,21 +342,36 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	log.Printf("[INFO] Creating database")
-	database, _, err := client.Database.Create(ctx, req)
-	if err != nil {
-		return diag.Errorf("error creating database: %v", err)
+	var database *govultr.Database
+	var err error
+	if restoreFrom, restoreFromOK := d.GetOk("restore_from"); restoreFromOK {
+		database, err = restoreVultrDatabase(ctx, client, req, restoreFrom.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return diag.Errorf("error restoring database: %v", err)
+		}
+	} else {
+		log.Printf("[INFO] Creating database")
+		database, _, err = client.Database.Create(ctx, req)
+		if err != nil {
+			return diag.Errorf("error creating database: %v", err)
+		}
 	}
 
 	d.SetId(database.ID)
-	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
+	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
 	_, errWait := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
 	if errWait != nil {
-		return diag.Errorf("error while waiting for Managed Database %s to be in an active state : %s", d.Id(), err)
+		return diag.Errorf("error while waiting for Managed Database %s to be in an active state : %s", d.Id(), errWait)
 	}
 
 	// Some values can only be properly set after creation
 	req2 := &govultr.DatabaseUpdateReq{}
+	restored := false
+	if _, restoreFromOK := d.GetOk("restore_from"); restoreFromOK {
+		// Restores copy the source configuration, so apply ours on top
+		req2 = databaseUpdateReqFromCreate(req)
+		restored = true
+	}
 	if clusterTimeZone, clusterTimeZoneOK := d.GetOk("cluster_time_zone"); clusterTimeZoneOK {
 		log.Printf("[INFO] Updating database default time zone")
 		req2.ClusterTimeZone = clusterTimeZone.(string)
@@ -324,7 +385,7 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Perform an update if needed
-	if req2.ClusterTimeZone != "" || req2.BackupHour != nil || req2.BackupMinute != nil {
+	if restored || req2.ClusterTimeZone != "" || req2.BackupHour != nil || req2.BackupMinute != nil {
 		if _, _, err := client.Database.Update(ctx, d.Id(), req2); err != nil {
 			return diag.Errorf("error updating post-creation values for database: %v", err)
 		}
@@ -342,6 +403,19 @@ func resourceVultrDatabaseCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if advancedOptions, advancedOptionsOK := d.GetOk("advanced_options"); advancedOptionsOK {
+		log.Printf("[INFO] Updating database advanced options")
+		values := advancedOptions.(map[string]interface{})
+		if err := updateDatabaseAdvancedOptions(ctx, client, d.Id(), values, nil); err != nil {
+			return diag.Errorf("error updating advanced options for database %s: %v", d.Id(), err)
+		}
+
+		_, errWait := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+		if errWait != nil {
+			return diag.Errorf("error while waiting for Managed Database %s to be in an active state : %s", d.Id(), errWait)
+		}
+	}
+
 	return resourceVultrDatabaseRead(ctx, d, meta)
 }
 
@@ -546,11 +620,61 @@ func resourceVultrDatabaseRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to set resource database `read_replicas` read value: %v", err)
 	}
 
+	// Only the options in state are tracked, the rest keep the engine defaults
+	if advancedOptions := d.Get("advanced_options").(map[string]interface{}); len(advancedOptions) != 0 {
+		options, err := getDatabaseAdvancedOptions(ctx, client, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting advanced options for database (%s): %v", d.Id(), err)
+		}
+
+		names := make([]string, 0, len(advancedOptions))
+		for name := range advancedOptions {
+			names = append(names, name)
+		}
+
+		if err := d.Set("advanced_options", flattenDatabaseAdvancedOptions(options.ConfiguredOptions, names)); err != nil {
+			return diag.Errorf("unable to set resource database `advanced_options` read value: %v", err)
+		}
+	}
+
 	return nil
 }
 func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 
+	// Version changes have their own API protocol/checks and go first so a
+	// failed upgrade doesn't leave the other changes half applied
+	if d.HasChange("database_engine_version") {
+		// Check available versions against input
+		log.Printf("[INFO] Checking available version upgrades")
+		availableVersions, _, err := client.Database.ListAvailableVersions(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("error checking available version upgrades %s : %s", d.Id(), err.Error())
+		}
+		_, newVal := d.GetChange("database_engine_version")
+		databaseEngineVersion := newVal.(string)
+		if !versionCompare(availableVersions, databaseEngineVersion) {
+			return diag.Errorf("invalid version %s provided for database %s", databaseEngineVersion, d.Id())
+		}
+
+		// Start version upgrade
+		log.Printf("[INFO] Initiating version upgrade")
+		req2 := &govultr.DatabaseVersionUpgradeReq{
+			Version: databaseEngineVersion,
+		}
+		message, _, err := client.Database.StartVersionUpgrade(ctx, d.Id(), req2)
+		if err != nil {
+			return diag.Errorf("error upgrading database version %s : %s", d.Id(), err.Error())
+		}
+		log.Printf("[INFO] Managed Database %s version upgrade started: %s", d.Id(), message)
+
+		// Wait for the upgraded version to be running
+		if _, errAvail := waitForDatabaseVersionUpgrade(ctx, d, databaseEngineVersion, meta); errAvail != nil {
+			return diag.Errorf("error while waiting for Managed Database %s to upgrade to version %s : %s",
+				d.Id(), databaseEngineVersion, errAvail)
+		}
+	}
+
 	req := &govultr.DatabaseUpdateReq{
 		Label: d.Get("label").(string),
 	}
@@ -696,7 +820,7 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("region") || d.HasChange("plan") || d.HasChange("vpc_id") {
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
+		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
 		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
 		if errAvail != nil {
 			return diag.Errorf(
@@ -721,44 +845,63 @@ func resourceVultrDatabaseUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	// Version changes have their own API protocol/checks
-	if d.HasChange("database_engine_version") {
-		// Check available versions against input
-		log.Printf("[INFO] Checking available version upgrades")
-		availableVersions, _, err := client.Database.ListAvailableVersions(ctx, d.Id())
-		if err != nil {
-			return diag.Errorf("error checking available version upgrades %s : %s", d.Id(), err.Error())
-		}
-		_, newVal := d.GetChange("database_engine_version")
-		databaseEngineVersion := newVal.(string)
-		if !versionCompare(availableVersions, databaseEngineVersion) {
-			return diag.Errorf("invalid version %s provided for database %s", databaseEngineVersion, d.Id())
-		}
-
-		// Start version upgrade
-		log.Printf("[INFO] Initiating version upgrade")
-		req2 := &govultr.DatabaseVersionUpgradeReq{
-			Version: databaseEngineVersion,
-		}
-		if _, _, err := client.Database.StartVersionUpgrade(ctx, d.Id(), req2); err != nil {
-			return diag.Errorf("error upgrading database version %s : %s", d.Id(), err.Error())
+	// Pending maintenance is applied whenever the trigger changes to a new value
+	if d.HasChange("apply_maintenance_trigger") && d.Get("apply_maintenance_trigger").(string) != "" {
+		if err := applyDatabaseMaintenance(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
 		}
+	}
 
-		// Wait for running state
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
-		_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
-		if errAvail != nil {
-			return diag.Errorf(
-				"error while waiting for Managed Database %s to be in an active state : %s",
-				d.Id(),
-				errAvail,
-			)
+	if d.HasChange("advanced_options") {
+		log.Printf("[INFO] Updating advanced options")
+		oldOptions, newOptions := d.GetChange("advanced_options")
+		advancedOptions := newOptions.(map[string]interface{})
+		removed := removedDatabaseAdvancedOptions(oldOptions.(map[string]interface{}), advancedOptions)
+		if len(advancedOptions) != 0 || len(removed) != 0 {
+			if err := updateDatabaseAdvancedOptions(ctx, client, d.Id(), advancedOptions, removed); err != nil {
+				return diag.Errorf("error updating advanced options for database %s : %v", d.Id(), err)
+			}
+
+			pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+			_, errAvail := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta)
+			if errAvail != nil {
+				return diag.Errorf(
+					"error while waiting for Managed Database %s to be in an active state : %s",
+					d.Id(),
+					errAvail,
+				)
+			}
 		}
 	}
 
 	return resourceVultrDatabaseRead(ctx, d, meta)
 }
 
+func applyDatabaseMaintenance(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).govultrClient()
+
+	updates, _, err := client.Database.ListMaintenanceUpdates(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting maintenance updates for database %s : %v", d.Id(), err)
+	}
+	if len(updates) == 0 {
+		log.Printf("[INFO] No pending maintenance updates for Managed Database %s", d.Id())
+		return nil
+	}
+
+	log.Printf("[INFO] Applying maintenance updates to Managed Database %s: %s", d.Id(), strings.Join(updates, ", "))
+	if _, _, err := client.Database.StartMaintenance(ctx, d.Id()); err != nil {
+		return fmt.Errorf("error starting maintenance for database %s : %v", d.Id(), err)
+	}
+
+	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
+	if _, err := waitForDatabaseAvailable(ctx, d, "Running", pendStatuses, "status", meta); err != nil {
+		return fmt.Errorf("error while waiting for Managed Database %s maintenance to finish : %v", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceVultrDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client).govultrClient()
 	log.Printf("[INFO] Deleting database (%s)", d.Id())
@@ -770,6 +913,135 @@ func resourceVultrDatabaseDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// restoreVultrDatabase creates a database from a backup of another one. The
+// restore API is used when the region and plan match the source database, and
+// the fork API otherwise.
+func restoreVultrDatabase(ctx context.Context, client *govultr.Client, req *govultr.DatabaseCreateReq, restoreFrom map[string]interface{}) (*govultr.Database, error) { //nolint:lll
+	sourceID := restoreFrom["database_id"].(string)
+
+	source, _, err := client.Database.Get(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting source database %s: %v", sourceID, err)
+	}
+
+	if err := checkDatabaseRestoreSource(source, req.DatabaseEngine, req.DatabaseEngineVersion); err != nil {
+		return nil, err
+	}
+
+	restoreType, date, clock := "basebackup", "", ""
+	if restoreFrom["backup_label"].(string) == "oldest" {
+		backups, _, err := client.Database.GetBackupInformation(ctx, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting backups of source database %s: %v", sourceID, err)
+		}
+		if backups.OldestBackup.Date == "" {
+			return nil, fmt.Errorf("source database %s has no backups", sourceID)
+		}
+
+		restoreType = "pitr"
+		date = backups.OldestBackup.Date
+		clock = backups.OldestBackup.Time
+	}
+
+	if pointInTime := restoreFrom["point_in_time"].(string); pointInTime != "" {
+		t, err := time.Parse(time.RFC3339, pointInTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid point_in_time %q: %v", pointInTime, err)
+		}
+
+		restoreType = "pitr"
+		date = t.UTC().Format("2006-01-02")
+		clock = t.UTC().Format("15:04:05")
+	}
+
+	if strings.EqualFold(source.Region, req.Region) && source.Plan == req.Plan {
+		log.Printf("[INFO] Restoring database from %s (%s)", sourceID, restoreType)
+		database, _, err := client.Database.RestoreFromBackup(ctx, sourceID, &govultr.DatabaseBackupRestoreReq{
+			Label: req.Label,
+			Type:  restoreType,
+			Date:  date,
+			Time:  clock,
+		})
+		return database, err
+	}
+
+	log.Printf("[INFO] Forking database from %s (%s) to %s in %s", sourceID, restoreType, req.Plan, req.Region)
+	database, _, err := client.Database.Fork(ctx, sourceID, &govultr.DatabaseForkReq{
+		Label:  req.Label,
+		Region: req.Region,
+		Plan:   req.Plan,
+		Type:   restoreType,
+		Date:   date,
+		Time:   clock,
+	})
+	return database, err
+}
+
+// checkDatabaseRestoreSource makes sure a database restored from source
+// matches the configured engine and version. Restores come up on the version
+// of the source, so any other version would be planned as an upgrade or a
+// downgrade straight after creation.
+func checkDatabaseRestoreSource(source *govultr.Database, engine, version string) error {
+	if !strings.EqualFold(source.DatabaseEngine, engine) {
+		return fmt.Errorf("source database %s runs %s, not %s", source.ID, source.DatabaseEngine, engine)
+	}
+
+	if source.DatabaseEngineVersion != version {
+		return fmt.Errorf("source database %s runs %s version %s, set database_engine_version to %s to restore from it",
+			source.ID, source.DatabaseEngine, source.DatabaseEngineVersion, source.DatabaseEngineVersion)
+	}
+
+	return nil
+}
+
+// resourceVultrDatabaseRestoreDiff checks the source of a new restored
+// database at plan time when it already exists
+func resourceVultrDatabaseRestoreDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || len(d.Get("restore_from").([]interface{})) == 0 {
+		return nil
+	}
+
+	if !d.NewValueKnown("restore_from.0.database_id") || !d.NewValueKnown("database_engine") ||
+		!d.NewValueKnown("database_engine_version") {
+		return nil
+	}
+
+	sourceID := d.Get("restore_from.0.database_id").(string)
+	source, _, err := meta.(*Client).govultrClient().Database.Get(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("error getting source database %s: %v", sourceID, err)
+	}
+
+	return checkDatabaseRestoreSource(source, d.Get("database_engine").(string), d.Get("database_engine_version").(string))
+}
+
+// databaseUpdateReqFromCreate returns an update request carrying the settings
+// of req which a restored database doesn't take from the configuration
+func databaseUpdateReqFromCreate(req *govultr.DatabaseCreateReq) *govultr.DatabaseUpdateReq {
+	update := &govultr.DatabaseUpdateReq{
+		Tag:                    req.Tag,
+		MaintenanceDOW:         req.MaintenanceDOW,
+		MaintenanceTime:        req.MaintenanceTime,
+		BackupHour:             req.BackupHour,
+		BackupMinute:           req.BackupMinute,
+		TrustedIPs:             req.TrustedIPs,
+		MySQLSQLModes:          req.MySQLSQLModes,
+		MySQLRequirePrimaryKey: req.MySQLRequirePrimaryKey,
+		MySQLSlowQueryLog:      req.MySQLSlowQueryLog,
+		MySQLLongQueryTime:     req.MySQLLongQueryTime,
+		EvictionPolicy:         req.EvictionPolicy,
+		EnableKafkaREST:        req.EnableKafkaREST,
+		EnableSchemaRegistry:   req.EnableSchemaRegistry,
+		EnableKafkaConnect:     req.EnableKafkaConnect,
+	}
+
+	if req.VPCID != "" {
+		update.VPCID = govultr.StringToStringPtr(req.VPCID)
+	}
+
+	return update
+}
+
 func waitForDatabaseAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
 	log.Printf(
 		"[INFO] Waiting for Managed Database (%s) to have %s of %s",
@@ -800,6 +1072,9 @@ func newDatabaseStateRefresh(ctx context.Context, d *schema.ResourceData, meta i
 
 		if attr == "status" {
 			log.Printf("[INFO] The Managed Database Status is %s", server.Status)
+			if server.Status == databaseErrorStatus {
+				return server, server.Status, databaseStatusError(ctx, client, d.Id())
+			}
 			return server, server.Status, nil
 		}
 
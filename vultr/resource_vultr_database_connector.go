@@ -3,7 +3,10 @@ package vultr
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +23,7 @@ func resourceVultrDatabaseConnector() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrDatabaseConnectorDiff,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database_id": {
@@ -46,6 +50,39 @@ func resourceVultrDatabaseConnector() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"restart_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"restart_failed_tasks_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -114,6 +151,24 @@ func resourceVultrDatabaseConnectorRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	// The status isn't available until the connector has been deployed, so a
+	// missing status is read as empty
+	connectorStatus, _, err := client.Database.GetConnectorStatus(ctx, databaseID, d.Id())
+	if err != nil && !strings.Contains(err.Error(), "\"status\":404") {
+		return diag.Errorf("error getting database connector status (%s): %v", d.Id(), err)
+	}
+	if err != nil || connectorStatus == nil {
+		connectorStatus = &govultr.DatabaseConnectorStatus{}
+	}
+
+	if err := d.Set("status", connectorStatus.State); err != nil {
+		return diag.Errorf("unable to set resource database connector `status` read value: %v", err)
+	}
+
+	if err := d.Set("tasks", flattenConnectorTasks(connectorStatus.Tasks)); err != nil {
+		return diag.Errorf("unable to set resource database connector `tasks` read value: %v", err)
+	}
+
 	return nil
 }
 
@@ -144,8 +199,35 @@ func resourceVultrDatabaseConnectorUpdate(ctx context.Context, d *schema.Resourc
 		req.Config = configMap
 	}
 
-	if _, _, err := client.Database.UpdateConnector(ctx, databaseID, d.Id(), req); err != nil {
-		return diag.Errorf("error updating database connector %s : %s", d.Id(), err.Error())
+	if d.HasChanges("topics", "config") {
+		if _, _, err := client.Database.UpdateConnector(ctx, databaseID, d.Id(), req); err != nil {
+			return diag.Errorf("error updating database connector %s : %s", d.Id(), err.Error())
+		}
+	}
+
+	if d.HasChange("restart_trigger") && d.Get("restart_trigger").(string) != "" {
+		log.Printf("[INFO] Restarting database connector (%s)", d.Id())
+		if err := client.Database.RestartConnector(ctx, databaseID, d.Id()); err != nil {
+			return diag.Errorf("error restarting database connector %s : %v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("restart_failed_tasks_trigger") && d.Get("restart_failed_tasks_trigger").(string) != "" {
+		connectorStatus, _, err := client.Database.GetConnectorStatus(ctx, databaseID, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting database connector status (%s): %v", d.Id(), err)
+		}
+
+		for _, task := range connectorStatus.Tasks {
+			if task.State != "FAILED" {
+				continue
+			}
+
+			log.Printf("[INFO] Restarting failed task %d of database connector (%s)", task.ID, d.Id())
+			if err := client.Database.RestartConnectorTask(ctx, databaseID, d.Id(), task.ID); err != nil {
+				return diag.Errorf("error restarting task %d of database connector %s : %v", task.ID, d.Id(), err)
+			}
+		}
 	}
 
 	return resourceVultrDatabaseConnectorRead(ctx, d, meta)
@@ -163,3 +245,83 @@ func resourceVultrDatabaseConnectorDelete(ctx context.Context, d *schema.Resourc
 
 	return nil
 }
+
+func flattenConnectorTasks(tasks []govultr.DatabaseConnectorTask) []map[string]interface{} {
+	var taskList []map[string]interface{}
+	for i := range tasks {
+		taskList = append(taskList, map[string]interface{}{
+			"id":    tasks[i].ID,
+			"state": tasks[i].State,
+			"trace": tasks[i].Trace,
+		})
+	}
+	return taskList
+}
+
+// resourceVultrDatabaseConnectorDiff checks the connector class and the
+// required keys of its config against the connectors available for the
+// database. It is skipped when the database doesn't exist yet.
+func resourceVultrDatabaseConnectorDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("class", "config") {
+		return nil
+	}
+	if !d.NewValueKnown("database_id") || !d.NewValueKnown("class") || !d.NewValueKnown("config") {
+		return nil
+	}
+
+	client := meta.(*Client).govultrClient()
+	databaseID := d.Get("database_id").(string)
+	class := d.Get("class").(string)
+
+	connectors, _, err := client.Database.ListAvailableConnectors(ctx, databaseID)
+	if err != nil {
+		return fmt.Errorf("error getting available connectors for database %s: %v", databaseID, err)
+	}
+
+	found := false
+	classes := make([]string, 0, len(connectors))
+	for i := range connectors {
+		classes = append(classes, connectors[i].Class)
+		found = found || connectors[i].Class == class
+	}
+	if !found {
+		return fmt.Errorf("`class` %q is not an available connector, available connectors: %s",
+			class, strings.Join(classes, ", "))
+	}
+
+	var configMap map[string]interface{}
+	if config := d.Get("config").(string); config != "" {
+		if err := json.Unmarshal([]byte(config), &configMap); err != nil {
+			return fmt.Errorf("error parsing JSON for field `config`: %v", err)
+		}
+	}
+
+	options, _, err := client.Database.GetConnectorConfigurationSchema(ctx, databaseID, class)
+	if err != nil {
+		return fmt.Errorf("error getting configuration schema for connector class %s: %v", class, err)
+	}
+
+	var missing []string
+	for i := range options {
+		if !options[i].Required || options[i].DefaultValue != "" || connectorManagedConfigKeys[options[i].Name] {
+			continue
+		}
+		if _, ok := configMap[options[i].Name]; !ok {
+			missing = append(missing, options[i].Name)
+		}
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("`config` is missing required keys for connector class %s: %s", class, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// connectorManagedConfigKeys are the connector config keys set from the
+// resource arguments rather than the config
+var connectorManagedConfigKeys = map[string]bool{
+	"name":            true,
+	"connector.class": true,
+	"topics":          true,
+}
@@ -3,6 +3,7 @@ package vultr
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -70,6 +71,48 @@ func TestAccVultrDatabaseConnectorUpdate(t *testing.T) {
 	})
 }
 
+func TestAccVultrDatabaseConnectorRestart(t *testing.T) {
+	skipCI(t)
+	pName := acctest.RandomWithPrefix("tf-db-rs")
+	rName := acctest.RandomWithPrefix("tf-db-connector-rs")
+
+	name := "vultr_database_connector.test_connector"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDatabaseConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseKafkaBase(pName) + testAccVultrDatabaseConnectorRestart(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_available_connectors.test", "connectors.#"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_available_connectors.test", "configuration_schema.#"),
+				),
+			},
+			{
+				PreConfig: func() { time.Sleep(60 * time.Second) },
+				Config:    testAccVultrDatabaseKafkaBase(pName) + testAccVultrDatabaseConnectorRestart(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "restart_trigger", "second"),
+					resource.TestCheckResourceAttr(name, "restart_failed_tasks_trigger", "second"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+				),
+			},
+			{
+				Config: testAccVultrDatabaseKafkaBase(pName) + fmt.Sprintf(`
+					resource "vultr_database_connector" "test_connector" {
+						database_id = vultr_database.test.id
+						name = "%s"
+						class = "com.example.MissingConnector"
+						topics = "tf-db-topic"
+					} `, rName),
+				ExpectError: regexp.MustCompile("is not an available connector"),
+			},
+		},
+	})
+}
+
 func testAccCheckVultrDatabaseConnectorDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vultr_database_connector" {
@@ -119,3 +162,25 @@ func testAccVultrDatabaseConnectorBaseUpdated(name string) string {
 			})
 		} `, name)
 }
+
+func testAccVultrDatabaseConnectorRestart(name, trigger string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database_connector" "test_connector" {
+			database_id = vultr_database.test.id
+			name = "%s"
+			class = "com.couchbase.connect.kafka.CouchbaseSinkConnector"
+			topics = "tf-db-topic"
+			config = jsonencode({
+				"couchbase.seed.nodes" = "3"
+				"couchbase.username" = "some_username"
+				"couchbase.password" = "some_password"
+			})
+			restart_trigger = "%s"
+			restart_failed_tasks_trigger = "%s"
+		}
+
+		data "vultr_database_available_connectors" "test" {
+			database_id = vultr_database.test.id
+			class = "com.couchbase.connect.kafka.CouchbaseSinkConnector"
+		} `, name, trigger, trigger)
+}
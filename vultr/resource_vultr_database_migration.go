@@ -0,0 +1,257 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrDatabaseMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrDatabaseMigrationCreate,
+		ReadContext:   resourceVultrDatabaseMigrationRead,
+		DeleteContext: resourceVultrDatabaseMigrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrDatabaseMigrationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			// Required
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"host": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			// Optional
+			"database": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"ignored_databases": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+func resourceVultrDatabaseMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+
+	var ignored []string
+	for _, v := range d.Get("ignored_databases").(*schema.Set).List() {
+		ignored = append(ignored, v.(string))
+	}
+	sort.Strings(ignored)
+
+	req := &govultr.DatabaseMigrationStartReq{
+		Host:             d.Get("host").(string),
+		Port:             d.Get("port").(int),
+		Username:         d.Get("username").(string),
+		Password:         d.Get("password").(string),
+		Database:         d.Get("database").(string),
+		IgnoredDatabases: strings.Join(ignored, ","),
+		SSL:              govultr.BoolToBoolPtr(d.Get("ssl").(bool)),
+	}
+
+	log.Printf("[INFO] Starting database migration")
+	if _, _, err := client.Database.StartMigration(ctx, databaseID, req); err != nil {
+		return diag.Errorf("error starting database migration: %v", err)
+	}
+
+	d.SetId(databaseID)
+
+	if _, err := waitForDatabaseMigration(ctx, d, meta); err != nil {
+		return diag.Errorf("error while waiting for database migration to %s: %v", databaseID, err)
+	}
+
+	return resourceVultrDatabaseMigrationRead(ctx, d, meta)
+}
+
+func resourceVultrDatabaseMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	migration, _, err := client.Database.GetMigrationStatus(ctx, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid database ID") || strings.Contains(err.Error(), "\"status\":404") {
+			log.Printf("[WARN] Removing database migration (%s) because it is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting database migration (%s): %v", d.Id(), err)
+	}
+
+	if migration == nil || migration.Status == "" {
+		log.Printf("[WARN] Removing database migration (%s) because it is gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("status", migration.Status); err != nil {
+		return diag.Errorf("unable to set resource database migration `status` read value: %v", err)
+	}
+	if err := d.Set("method", migration.Method); err != nil {
+		return diag.Errorf("unable to set resource database migration `method` read value: %v", err)
+	}
+	if err := d.Set("error", migration.Error); err != nil {
+		return diag.Errorf("unable to set resource database migration `error` read value: %v", err)
+	}
+
+	// The password is never returned, and the other credentials may be left
+	// out, so only overwrite values the API reports
+	creds := migration.Credentials
+	if creds.Host != "" {
+		if err := d.Set("host", creds.Host); err != nil {
+			return diag.Errorf("unable to set resource database migration `host` read value: %v", err)
+		}
+	}
+	if creds.Port != 0 {
+		if err := d.Set("port", creds.Port); err != nil {
+			return diag.Errorf("unable to set resource database migration `port` read value: %v", err)
+		}
+	}
+	if creds.Username != "" {
+		if err := d.Set("username", creds.Username); err != nil {
+			return diag.Errorf("unable to set resource database migration `username` read value: %v", err)
+		}
+	}
+	if creds.Database != "" {
+		if err := d.Set("database", creds.Database); err != nil {
+			return diag.Errorf("unable to set resource database migration `database` read value: %v", err)
+		}
+	}
+	if creds.IgnoredDatabases != "" {
+		if err := d.Set("ignored_databases", strings.Split(creds.IgnoredDatabases, ",")); err != nil {
+			return diag.Errorf("unable to set resource database migration `ignored_databases` read value: %v", err)
+		}
+	}
+	if creds.SSL != nil {
+		if err := d.Set("ssl", *creds.SSL); err != nil {
+			return diag.Errorf("unable to set resource database migration `ssl` read value: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVultrDatabaseMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+	log.Printf("[INFO] Detaching database migration (%s)", d.Id())
+
+	if err := client.Database.DetachMigration(ctx, d.Id()); err != nil {
+		if strings.Contains(err.Error(), "invalid database ID") || strings.Contains(err.Error(), "\"status\":404") {
+			return nil
+		}
+		return diag.Errorf("error detaching database migration (%s): %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVultrDatabaseMigrationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	if err := d.Set("database_id", d.Id()); err != nil {
+		return nil, fmt.Errorf("unable to set `database_id` for import state function")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// waitForDatabaseMigration waits until a migration has copied the existing
+// data. Migrations using replication keep syncing afterwards, which also
+// counts as complete.
+func waitForDatabaseMigration(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	log.Printf("[INFO] Waiting for Managed Database migration (%s) to complete", d.Id())
+
+	client := meta.(*Client).govultrClient()
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"running"},
+		Target:  []string{"done", "syncing"},
+		Refresh: func() (interface{}, string, error) {
+			migration, _, err := client.Database.GetMigrationStatus(ctx, d.Id())
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving Managed Database migration %s : %s", d.Id(), err)
+			}
+
+			if migration == nil {
+				return nil, "running", nil
+			}
+
+			log.Printf("[INFO] The Managed Database migration status is %s", migration.Status)
+			switch migration.Status {
+			case "done", "syncing":
+				return migration, migration.Status, nil
+			case "failed":
+				return migration, migration.Status, fmt.Errorf("migration failed: %s", migration.Error)
+			default:
+				return migration, "running", nil
+			}
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,59 @@
+package vultr
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVultrDatabaseMigrationBasic(t *testing.T) {
+	skipCI(t)
+	pName := acctest.RandomWithPrefix("tf-db-rs")
+	sName := acctest.RandomWithPrefix("tf-db-src")
+
+	name := "vultr_database_migration.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVultrDatabaseDestroy,
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseBase(pName) + testAccVultrDatabaseMigrationBase(sName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "database_id", "vultr_database.test", "id"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttr(name, "error", ""),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccVultrDatabaseMigrationBase(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database" "source" {
+			database_engine = "pg"
+			database_engine_version = "15"
+			region = "sea"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s"
+		}
+
+		resource "vultr_database_migration" "test" {
+			database_id = vultr_database.test.id
+			host = vultr_database.source.host
+			port = vultr_database.source.port
+			username = vultr_database.source.user
+			password = vultr_database.source.password
+			database = vultr_database.source.dbname
+			ssl = true
+		} `, name)
+}
@@ -56,7 +56,7 @@ func resourceVultrDatabaseReplicaCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(database.ID)
 
-	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
+	pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
 	_, errAvail := waitForDatabaseReplicaAvailable(ctx, d, "Running", pendStatuses, "status", meta)
 	if errAvail != nil {
 		return diag.Errorf(
@@ -289,7 +289,7 @@ func resourceVultrDatabaseReplicaUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("region") || d.HasChange("vpc_id") {
-		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring", "Error"}
+		pendStatuses := []string{"Rebalancing", "Rebuilding", "Configuring"}
 		_, errAvail := waitForDatabaseReplicaAvailable(ctx, d, "Running", pendStatuses, "status", meta)
 		if errAvail != nil {
 			return diag.Errorf(
@@ -344,6 +344,9 @@ func newDatabaseReplicaStateRefresh(ctx context.Context, d *schema.ResourceData,
 
 		if attr == "status" {
 			log.Printf("[INFO] The Managed Database read replica Status is %s", server.Status)
+			if server.Status == databaseErrorStatus {
+				return server, server.Status, databaseStatusError(ctx, client, d.Id())
+			}
 			return server, server.Status, nil
 		}
 
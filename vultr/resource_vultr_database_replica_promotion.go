@@ -0,0 +1,154 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrDatabaseReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrDatabaseReplicaPromotionCreate,
+		ReadContext:   resourceVultrDatabaseReplicaPromotionRead,
+		DeleteContext: resourceVultrDatabaseReplicaPromotionDelete,
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"replica_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+		},
+	}
+}
+
+func resourceVultrDatabaseReplicaPromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	databaseID := d.Get("database_id").(string)
+	replicaID := d.Get("replica_id").(string)
+
+	// Replicas are usually promoted because the parent is gone or unreachable,
+	// so the parent is only used to catch mistakes when it can be read. The
+	// promotion API rejects databases which aren't replicas either way.
+	parent, _, err := client.Database.Get(ctx, databaseID)
+	switch {
+	case err != nil:
+		log.Printf("[WARN] Unable to check database %s is a read replica of database %s: %v", replicaID, databaseID, err)
+	case !hasDatabaseReadReplica(parent, replicaID):
+		return diag.Errorf("database %s is not a read replica of database %s", replicaID, databaseID)
+	}
+
+	log.Printf("[INFO] Promoting database read replica %s", replicaID)
+	if err := client.Database.PromoteReadReplica(ctx, replicaID); err != nil {
+		return diag.Errorf("error promoting database read replica %s: %v", replicaID, err)
+	}
+
+	d.SetId(replicaID)
+
+	if _, err := waitForDatabaseReplicaPromotion(ctx, d, meta); err != nil {
+		return diag.Errorf("error while waiting for database read replica %s to be promoted: %v", replicaID, err)
+	}
+
+	return resourceVultrDatabaseReplicaPromotionRead(ctx, d, meta)
+}
+
+func resourceVultrDatabaseReplicaPromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	database, _, err := client.Database.Get(ctx, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid database ID") {
+			log.Printf("[WARN] Removing database replica promotion (%s) because the database is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting promoted database (%s): %v", d.Id(), err)
+	}
+
+	if err := d.Set("status", database.Status); err != nil {
+		return diag.Errorf("unable to set resource database_replica_promotion `status` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrDatabaseReplicaPromotionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	// A promoted database can't be turned back into a replica, so there is
+	// nothing to undo
+	log.Printf("[INFO] Removing database replica promotion (%s) from state only", d.Id())
+	return nil
+}
+
+// hasDatabaseReadReplica returns whether replicaID is listed as a read replica
+// of database
+func hasDatabaseReadReplica(database *govultr.Database, replicaID string) bool {
+	for i := range database.ReadReplicas {
+		if database.ReadReplicas[i].ID == replicaID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func waitForDatabaseReplicaPromotion(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for Managed Database read replica (%s) to be promoted", d.Id())
+
+	client := meta.(*Client).govultrClient()
+	databaseID := d.Get("database_id").(string)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"promoting"},
+		Target:  []string{"promoted"},
+		Refresh: func() (interface{}, string, error) {
+			database, _, err := client.Database.Get(ctx, d.Id())
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving Managed Database %s : %s", d.Id(), err)
+			}
+
+			// A parent that can't be read no longer lists the replica, so the
+			// promotion is decided by the replica's own status
+			isReplica := false
+			if parent, _, err := client.Database.Get(ctx, databaseID); err == nil {
+				isReplica = hasDatabaseReadReplica(parent, d.Id())
+			} else {
+				log.Printf("[WARN] Unable to get parent Managed Database %s : %s", databaseID, err)
+			}
+
+			log.Printf("[INFO] The Managed Database Status is %s, still a replica: %t", database.Status, isReplica)
+			if isReplica || database.Status != "Running" {
+				return database, "promoting", nil
+			}
+
+			return database, "promoted", nil
+		},
+		Timeout:                   d.Timeout(schema.TimeoutCreate),
+		Delay:                     10 * time.Second,
+		MinTimeout:                3 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
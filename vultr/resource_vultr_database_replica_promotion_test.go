@@ -0,0 +1,64 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrDatabaseReplicaPromotion(t *testing.T) {
+	skipCI(t)
+	pName := acctest.RandomWithPrefix("tf-db-rs")
+	rName := acctest.RandomWithPrefix("tf-db-replica")
+
+	name := "vultr_database_replica_promotion.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		CheckDestroy:      testAccCheckVultrDatabaseReplicaDestroy,
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseBase(pName) + testAccVultrDatabaseReplicaBase(rName) +
+					testAccVultrDatabaseReplicaPromotion(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "status", "Running"),
+					resource.TestCheckResourceAttrPair(name, "id", "vultr_database_replica.test_replica", "id"),
+					testAccCheckVultrDatabaseReplicaPromoted(name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrDatabaseReplicaPromoted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("database replica promotion not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		parent, _, err := client.Database.Get(context.Background(), rs.Primary.Attributes["database_id"])
+		if err != nil {
+			return err
+		}
+
+		if hasDatabaseReadReplica(parent, rs.Primary.ID) {
+			return fmt.Errorf("database %s is still a read replica", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVultrDatabaseReplicaPromotion() string {
+	return `
+		resource "vultr_database_replica_promotion" "test" {
+			database_id = vultr_database.test.id
+			replica_id = vultr_database_replica.test_replica.id
+		} `
+}
@@ -3,6 +3,7 @@ package vultr
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -153,6 +154,174 @@ func TestAccVultrDatabaseUpdatePlan(t *testing.T) {
 	})
 }
 
+func TestAccVultrDatabaseRestoreFrom(t *testing.T) {
+	skipCI(t)
+	rName := acctest.RandomWithPrefix("tf-db-rs-rst")
+
+	name := "vultr_database.restored"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseBase(rName),
+			},
+			{
+				Config:      testAccVultrDatabaseBase(rName) + testAccVultrDatabaseRestoreFrom(rName, "16"),
+				ExpectError: regexp.MustCompile(`set database_engine_version to 15 to restore from it`),
+			},
+			{
+				Config: testAccVultrDatabaseBase(rName) + testAccVultrDatabaseRestoreFrom(rName, "15"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vultr_database_backups.test", "latest_backup.0.timestamp"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_backups.test", "oldest_backup.0.date"),
+					resource.TestCheckResourceAttr(name, "label", rName+"-restored"),
+					resource.TestCheckResourceAttr(name, "region", "EWR"),
+					resource.TestCheckResourceAttr(name, "status", "Running"),
+					resource.TestCheckResourceAttr(name, "maintenance_dow", "saturday"),
+					resource.TestCheckResourceAttrPair(name, "restore_from.0.database_id", "vultr_database.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVultrDatabaseVersionUpgrade(t *testing.T) {
+	skipCI(t)
+	rName := acctest.RandomWithPrefix("tf-db-rs-ver")
+
+	name := "vultr_database.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseVersion(rName, "pg", "15"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "database_engine_version", "15"),
+				),
+			},
+			{
+				Config:      testAccVultrDatabaseVersion(rName, "pg", "99"),
+				ExpectError: regexp.MustCompile(`database_engine_version 99 is not available`),
+			},
+			{
+				Config: testAccVultrDatabaseVersion(rName, "pg", "16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "database_engine_version", "16"),
+					resource.TestCheckResourceAttr(name, "status", "Running"),
+				),
+			},
+			{
+				Config:      testAccVultrDatabaseVersion(rName, "pg", "15"),
+				ExpectError: regexp.MustCompile(`cannot be downgraded from 16 to 15`),
+			},
+			{
+				Config: testAccVultrDatabaseVersion(rName, "mysql", "8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "database_engine", "mysql"),
+					resource.TestCheckResourceAttr(name, "database_engine_version", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVultrDatabaseMaintenance(t *testing.T) {
+	skipCI(t)
+	rName := acctest.RandomWithPrefix("tf-db-rs-mnt")
+
+	name := "vultr_database.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseMaintenance(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "apply_maintenance_trigger", "first"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_usage.test", "disk.0.max_gb"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_usage.test", "memory.0.max_mb"),
+					resource.TestCheckResourceAttrSet("data.vultr_database_usage.test", "cpu.0.percentage"),
+					resource.TestCheckResourceAttr("data.vultr_database_alerts.test", "period", "week"),
+				),
+			},
+			{
+				Config: testAccVultrDatabaseMaintenance(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "apply_maintenance_trigger", "second"),
+					resource.TestCheckResourceAttr(name, "status", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVultrDatabaseAdvancedOptions(t *testing.T) {
+	skipCI(t)
+	rName := acctest.RandomWithPrefix("tf-db-rs-adv")
+
+	name := "vultr_database.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrDatabaseAdvancedOptions(rName, "0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "advanced_options.%", "2"),
+					resource.TestCheckResourceAttr(name, "advanced_options.autovacuum_analyze_scale_factor", "0.2"),
+					resource.TestCheckResourceAttr(name, "advanced_options.jit", "true"),
+				),
+			},
+			{
+				Config: testAccVultrDatabaseAdvancedOptions(rName, "0.15"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "advanced_options.autovacuum_analyze_scale_factor", "0.15"),
+				),
+			},
+			{
+				Config:      testAccVultrDatabaseAdvancedOptions(rName, "2"),
+				ExpectError: regexp.MustCompile(`"autovacuum_analyze_scale_factor" must be at most`),
+			},
+			{
+				Config: testAccVultrDatabaseAdvancedOptionsWithoutJIT(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "advanced_options.%", "1"),
+					testAccCheckVultrDatabaseAdvancedOptionReset(name, "jit"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckVultrDatabaseAdvancedOptionReset checks an option removed from
+// advanced_options is no longer configured on the database
+func testAccCheckVultrDatabaseAdvancedOptionReset(n, option string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("database not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		options, err := getDatabaseAdvancedOptions(context.Background(), client, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error getting advanced options of database %s: %v", rs.Primary.ID, err)
+		}
+
+		if value, ok := options.ConfiguredOptions[option]; ok && value != nil {
+			return fmt.Errorf("advanced option %s is still set to %v", option, value)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckVultrDatabaseDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vultr_database" {
@@ -221,6 +390,28 @@ func testAccVultrDatabaseBaseUpdatePlan(name string) string {
 		} `, name)
 }
 
+func testAccVultrDatabaseRestoreFrom(name, version string) string {
+	return fmt.Sprintf(`
+		data "vultr_database_backups" "test" {
+			database_id = vultr_database.test.id
+		}
+
+		resource "vultr_database" "restored" {
+			database_engine = "pg"
+			database_engine_version = "%s"
+			region = "ewr"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s-restored"
+			maintenance_dow = "saturday"
+			maintenance_time = "02:00"
+
+			restore_from {
+				database_id = vultr_database.test.id
+				point_in_time = data.vultr_database_backups.test.latest_backup[0].timestamp
+			}
+		} `, version, name)
+}
+
 func testAccVultrDatabaseKafkaBase(name string) string {
 	return fmt.Sprintf(`
 		resource "vultr_database" "test" {
@@ -232,3 +423,66 @@ func testAccVultrDatabaseKafkaBase(name string) string {
 			tag = "test tag"
 		} `, name)
 }
+
+func testAccVultrDatabaseAdvancedOptions(name, scaleFactor string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database" "test" {
+			database_engine = "pg"
+			database_engine_version = "15"
+			region = "sea"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s"
+
+			advanced_options = {
+				autovacuum_analyze_scale_factor = "%s"
+				jit = "true"
+			}
+		} `, name, scaleFactor)
+}
+
+func testAccVultrDatabaseAdvancedOptionsWithoutJIT(name string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database" "test" {
+			database_engine = "pg"
+			database_engine_version = "15"
+			region = "sea"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s"
+
+			advanced_options = {
+				autovacuum_analyze_scale_factor = "0.15"
+			}
+		} `, name)
+}
+
+func testAccVultrDatabaseVersion(name, engine, version string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database" "test" {
+			database_engine = "%s"
+			database_engine_version = "%s"
+			region = "sea"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s"
+		} `, engine, version, name)
+}
+
+func testAccVultrDatabaseMaintenance(name, trigger string) string {
+	return fmt.Sprintf(`
+		resource "vultr_database" "test" {
+			database_engine = "pg"
+			database_engine_version = "15"
+			region = "sea"
+			plan = "vultr-dbaas-startup-cc-1-55-2"
+			label = "%s"
+			apply_maintenance_trigger = "%s"
+		}
+
+		data "vultr_database_usage" "test" {
+			database_id = vultr_database.test.id
+		}
+
+		data "vultr_database_alerts" "test" {
+			database_id = vultr_database.test.id
+			period = "week"
+		} `, name, trigger)
+}
@@ -43,9 +43,10 @@ func resourceVultrDatabaseUser() *schema.Resource {
 				Computed: true,
 			},
 			"permission": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"admin", "read", "write", "readwrite"}, false),
 			},
 			"access_control": {
 				Type:     schema.TypeSet,
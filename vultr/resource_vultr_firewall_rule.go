@@ -113,7 +113,9 @@ func resourceVultrFirewallRuleRead(ctx context.Context, d *schema.ResourceData,
 	ruleID, _ := strconv.Atoi(d.Id())
 	fw, _, err := client.FirewallRule.Get(ctx, d.Get("firewall_group_id").(string), ruleID)
 	if err != nil {
-		if strings.Contains(err.Error(), "Firewall rule ID not found") {
+		// A 404 means the whole group is gone, e.g. a managed VKE firewall
+		// group after the cluster's firewall is disabled
+		if strings.Contains(err.Error(), "Firewall rule ID not found") || strings.Contains(err.Error(), "\"status\":404") {
 			tflog.Warn(ctx,
 				fmt.Sprintf(
 					"Removing firewall rule ID (%s) in group (%s) because it is gone",
@@ -163,6 +165,9 @@ func resourceVultrFirewallRuleDelete(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[INFO] Delete firewall rule : %s", d.Id())
 	if err := client.FirewallRule.Delete(ctx, d.Get("firewall_group_id").(string), id); err != nil {
+		if strings.Contains(err.Error(), "\"status\":404") {
+			return nil
+		}
 		return diag.Errorf("error destroying firewall rule %s: %v", d.Id(), err)
 	}
 	return nil
@@ -2,7 +2,6 @@ package vultr
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
 	"log"
 	"strings"
@@ -114,10 +113,18 @@ Will not do anything unless enable_ipv6 is also true.`,
 				Optional: true,
 			},
 			"user_data": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressUserDataHashDiff,
+				ValidateFunc:     validateUserData(false),
+			},
+			"user_data_hash_only": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Default:  false,
+				Description: `Store only a SHA-256 hash of user_data in state
+rather than the user data itself. Useful for large cloud-init payloads.`,
 			},
 			"activation_email": {
 				Type:     schema.TypeBool,
@@ -157,12 +164,13 @@ hostname on UI or API issues a reinstall of the OS.`,
 			"backups": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "disabled",
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
 			},
 			"backups_schedule": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -326,7 +334,7 @@ func resourceVultrInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		DisablePublicIPv4: govultr.BoolToBoolPtr(d.Get("disable_public_ipv4").(bool)),
 		Label:             d.Get("label").(string),
 		Backups:           backups,
-		UserData:          base64.StdEncoding.EncodeToString([]byte(d.Get("user_data").(string))),
+		UserData:          encodeUserData(d.Get("user_data").(string)),
 		ActivationEmail:   govultr.BoolToBoolPtr(d.Get("activation_email").(bool)),
 		DDOSProtection:    govultr.BoolToBoolPtr(d.Get("ddos_protection").(bool)),
 		Hostname:          d.Get("hostname").(string),
@@ -532,6 +540,15 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("unable to set resource instance `user_scheme` read value: %v", err)
 	}
 
+	userData, _, err := client.Instance.GetUserData(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error getting user data for instance (%s): %v", d.Id(), err)
+	}
+
+	if err := d.Set("user_data", userDataStateValue(d, decodeUserData(userData))); err != nil {
+		return diag.Errorf("unable to set resource instance `user_data` read value: %v", err)
+	}
+
 	backup, _, err := client.Instance.GetBackupSchedule(ctx, d.Id())
 	if err != nil {
 		return diag.Errorf("error getting backup schedule: %v", err)
@@ -570,6 +587,8 @@ func resourceVultrInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("%s", err.Error())
 	}
 
+	// vpc_ids is only managed here when it is configured, so that attachments
+	// made through vultr_instance_vpc_attachment don't show up as drift
 	if _, vpcUpdate := d.GetOk("vpc_ids"); vpcUpdate {
 		if err := d.Set("vpc_ids", vpcs); err != nil {
 			return diag.Errorf("unable to set resource instance `vpc_ids` read value: %v", err)
@@ -601,6 +620,12 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		req.Plan = plan
 	}
 
+	if d.HasChange("user_data") {
+		log.Printf("[INFO] Updating User Data")
+		_, newVal := d.GetChange("user_data")
+		req.UserData = encodeUserData(newVal.(string))
+	}
+
 	if d.HasChange("ddos_protection") {
 		log.Printf("[INFO] Updating DDOS Protection")
 		_, newVal := d.GetChange("ddos_protection")
@@ -608,7 +633,12 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		req.DDOSProtection = &ddos
 	}
 
-	bs, bsOK := d.GetOk("backups_schedule")
+	// Both backup attributes are computed so that backups can instead be
+	// managed by vultr_instance_backup_schedule, so only validate them
+	// against each other when they are configured here
+	bs := d.Get("backups_schedule")
+	bsOK := isAttrConfigured(d, "backups_schedule")
+	backupsOK := isAttrConfigured(d, "backups")
 	_, newBackupValue := d.GetChange("backups")
 	if d.HasChange("backups") {
 		log.Printf("[INFO] Updating Backups")
@@ -682,13 +712,13 @@ func resourceVultrInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	if newBackupValue.(string) == "enabled" && !bsOK {
+	if backupsOK && newBackupValue.(string) == "enabled" && !bsOK {
 		return diag.Errorf("Backups are being set to enabled please add backups_schedule")
 	}
 
 	// If we are disabling backups we don't do anything.
 	// On the read that gets called we will nil out backups_schedule.
-	if newBackupValue.(string) != "disabled" && d.HasChange("backups_schedule") {
+	if newBackupValue.(string) != "disabled" && bsOK && d.HasChange("backups_schedule") {
 		schedule := generateBackupSchedule(bs)
 		if _, err := client.Instance.SetBackupSchedule(ctx, d.Id(), schedule); err != nil {
 			return diag.Errorf("error setting backup for %s : %v", d.Id(), err)
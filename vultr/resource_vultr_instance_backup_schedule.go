@@ -0,0 +1,172 @@
+package vultr
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrInstanceBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrInstanceBackupScheduleCreate,
+		ReadContext:   resourceVultrInstanceBackupScheduleRead,
+		UpdateContext: resourceVultrInstanceBackupScheduleUpdate,
+		DeleteContext: resourceVultrInstanceBackupScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice(
+					[]string{
+						"daily",
+						"weekly",
+						"monthly",
+						"daily_alt_even",
+						"daily_alt_odd",
+					},
+					false,
+				),
+			},
+			"hour": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"dow": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+			"dom": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 28),
+			},
+			// Computed
+			"next_scheduled_time_utc": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVultrInstanceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	instanceID := d.Get("instance_id").(string)
+
+	backup, _, err := client.Instance.GetBackupSchedule(ctx, instanceID)
+	if err != nil {
+		return diag.Errorf("error getting backup schedule for instance %s: %v", instanceID, err)
+	}
+
+	if backupStatus(backup.Enabled) == "disabled" {
+		log.Printf("[INFO] Enabling backups on instance %s", instanceID)
+		if _, _, err := client.Instance.Update(ctx, instanceID, &govultr.InstanceUpdateReq{Backups: "enabled"}); err != nil {
+			return diag.Errorf("error enabling backups on instance %s: %v", instanceID, err)
+		}
+	}
+
+	log.Printf("[INFO] Setting backup schedule on instance %s", instanceID)
+	if _, err := client.Instance.SetBackupSchedule(ctx, instanceID, buildInstanceBackupScheduleReq(d)); err != nil {
+		return diag.Errorf("error setting backup schedule for instance %s: %v", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceVultrInstanceBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceVultrInstanceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	backup, _, err := client.Instance.GetBackupSchedule(ctx, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid instance ID") {
+			log.Printf("[WARN] Removing instance backup schedule (%s) because the instance is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting backup schedule for instance %s: %v", d.Id(), err)
+	}
+
+	if backupStatus(backup.Enabled) == "disabled" {
+		log.Printf("[WARN] Removing instance backup schedule (%s) because backups are disabled", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("instance_id", d.Id()); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `instance_id` read value: %v", err)
+	}
+	if err := d.Set("type", backup.Type); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `type` read value: %v", err)
+	}
+	if err := d.Set("hour", backup.Hour); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `hour` read value: %v", err)
+	}
+	if err := d.Set("dow", backup.Dow); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `dow` read value: %v", err)
+	}
+	if err := d.Set("dom", backup.Dom); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `dom` read value: %v", err)
+	}
+	if err := d.Set("next_scheduled_time_utc", backup.NextScheduleTimeUTC); err != nil {
+		return diag.Errorf("unable to set resource instance_backup_schedule `next_scheduled_time_utc` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrInstanceBackupScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Updating backup schedule on instance %s", d.Id())
+	if _, err := client.Instance.SetBackupSchedule(ctx, d.Id(), buildInstanceBackupScheduleReq(d)); err != nil {
+		return diag.Errorf("error updating backup schedule for instance %s: %v", d.Id(), err)
+	}
+
+	return resourceVultrInstanceBackupScheduleRead(ctx, d, meta)
+}
+
+func resourceVultrInstanceBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Disabling backups on instance %s", d.Id())
+	if _, _, err := client.Instance.Update(ctx, d.Id(), &govultr.InstanceUpdateReq{Backups: "disabled"}); err != nil {
+		if strings.Contains(err.Error(), "invalid instance ID") {
+			return nil
+		}
+		return diag.Errorf("error disabling backups on instance %s: %v", d.Id(), err)
+	}
+
+	return nil
+}
+
+func buildInstanceBackupScheduleReq(d *schema.ResourceData) *govultr.BackupScheduleReq {
+	return &govultr.BackupScheduleReq{
+		Type: d.Get("type").(string),
+		Hour: govultr.IntToIntPtr(d.Get("hour").(int)),
+		Dom:  d.Get("dom").(int),
+		Dow:  govultr.IntToIntPtr(d.Get("dow").(int)),
+	}
+}
@@ -0,0 +1,92 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrInstanceBackupScheduleBasic(t *testing.T) {
+	t.Parallel()
+
+	name := "vultr_instance_backup_schedule.test"
+	serverLabel := acctest.RandomWithPrefix("tf-rs-vps-backup-schedule")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrInstanceBackupScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrInstanceBackupSchedule(serverLabel, "weekly", 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "instance_id"),
+					resource.TestCheckResourceAttr(name, "type", "weekly"),
+					resource.TestCheckResourceAttr(name, "dow", "4"),
+					resource.TestCheckResourceAttr(name, "hour", "11"),
+					resource.TestCheckResourceAttrSet(name, "next_scheduled_time_utc"),
+				),
+			},
+			{
+				Config: testAccVultrInstanceBackupSchedule(serverLabel, "weekly", 6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "weekly"),
+					resource.TestCheckResourceAttr(name, "dow", "6"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckVultrInstanceBackupScheduleDestroy checks that the instance is
+// gone or, if it still exists, that its backups have been disabled
+func testAccCheckVultrInstanceBackupScheduleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_instance_backup_schedule" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		schedule, _, err := client.Instance.GetBackupSchedule(context.Background(), rs.Primary.ID)
+		if err != nil {
+			if strings.Contains(err.Error(), "Server is pending destruction") || strings.Contains(err.Error(), "invalid instance ID") {
+				continue
+			}
+			return fmt.Errorf("error getting backup schedule: %s", err)
+		}
+
+		if schedule.Enabled != nil && *schedule.Enabled {
+			return fmt.Errorf("instance %s still has backups enabled", rs.Primary.ID)
+		}
+	}
+
+	return testAccCheckVultrInstanceDestroy(s)
+}
+
+func testAccVultrInstanceBackupSchedule(serverLabel, scheduleType string, dow int) string {
+	return fmt.Sprintf(`
+		resource "vultr_instance" "foo" {
+			plan = "vc2-1c-2gb"
+			region = "sea"
+			os_id = "167"
+			label = "%s"
+		}
+
+		resource "vultr_instance_backup_schedule" "test" {
+			instance_id = "${vultr_instance.foo.id}"
+			type = "%s"
+			dow = %d
+			hour = 11
+		}
+	`, serverLabel, scheduleType, dow)
+}
@@ -245,6 +245,41 @@ func TestAccVultrInstanceUpdateTags(t *testing.T) {
 	})
 }
 
+func TestAccVultrInstanceUpdateUserData(t *testing.T) {
+	t.Parallel()
+	rName := acctest.RandomWithPrefix("tf-vps-rs-upud")
+
+	name := "vultr_instance.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrInstanceBaseUserData(rName, "#cloud-config\npackages:\n  - git", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", rName),
+					resource.TestCheckResourceAttr(name, "user_data", "#cloud-config\npackages:\n  - git"),
+				),
+			},
+			{
+				Config: testAccVultrInstanceBaseUserData(rName, "#cloud-config\npackages:\n  - curl", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", rName),
+					resource.TestCheckResourceAttr(name, "user_data", "#cloud-config\npackages:\n  - curl"),
+				),
+			},
+			{
+				Config: testAccVultrInstanceBaseUserData(rName, "#cloud-config\npackages:\n  - curl", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "label", rName),
+					resource.TestCheckResourceAttr(name, "user_data", userDataHash("#cloud-config\npackages:\n  - curl")),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVultrInstanceDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vultr_instance" {
@@ -404,3 +439,16 @@ func testAccVultrInstanceBaseUpdateTags(name string) string {
 			}
 		} `, name)
 }
+
+func testAccVultrInstanceBaseUserData(name, userData string, hashOnly bool) string {
+	return fmt.Sprintf(`
+		resource "vultr_instance" "test" {
+			plan = "vc2-1c-2gb"
+			region = "sea"
+			os_id = 167
+			label = "%s"
+			hostname = "testing-the-hostname"
+			user_data = %q
+			user_data_hash_only = %t
+		} `, name, userData, hashOnly)
+}
@@ -0,0 +1,260 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrInstanceVPCAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrInstanceVPCAttachmentCreate,
+		ReadContext:   resourceVultrInstanceVPCAttachmentRead,
+		DeleteContext: resourceVultrInstanceVPCAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrInstanceVPCAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"instance_id", "bare_metal_server_id"},
+			},
+			"bare_metal_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"instance_id", "bare_metal_server_id"},
+			},
+			// Computed
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mac_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceVultrInstanceVPCAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	serverID, bareMetal := vpcAttachmentServerID(d)
+	vpcID := d.Get("vpc_id").(string)
+
+	log.Printf("[INFO] Attaching VPC %s to server %s", vpcID, serverID)
+
+	var err error
+	if bareMetal {
+		err = client.BareMetalServer.AttachVPC(ctx, serverID, vpcID)
+	} else {
+		err = client.Instance.AttachVPC(ctx, serverID, vpcID)
+	}
+	if err != nil {
+		return diag.Errorf("error attaching VPC %s to server %s: %v", vpcID, serverID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", serverID, vpcID))
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if _, err := waitForVPCAttachment(ctx, d, "attached", []string{"detached"}, timeout, meta); err != nil {
+		return diag.Errorf("error while waiting for VPC %s to attach to server %s: %v", vpcID, serverID, err)
+	}
+
+	return resourceVultrInstanceVPCAttachmentRead(ctx, d, meta)
+}
+
+func resourceVultrInstanceVPCAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	serverID, bareMetal := vpcAttachmentServerID(d)
+	vpcID := d.Get("vpc_id").(string)
+
+	vpcInfo, err := getVPCAttachment(ctx, client, serverID, vpcID, bareMetal)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid instance ID") || strings.Contains(err.Error(), "Invalid server") {
+			log.Printf("[WARN] Removing VPC attachment (%s) because the server is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting VPC attachment (%s): %v", d.Id(), err)
+	}
+
+	if vpcInfo == nil {
+		log.Printf("[WARN] Removing VPC attachment (%s) because it is gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("ip_address", vpcInfo.IPAddress); err != nil {
+		return diag.Errorf("unable to set resource instance_vpc_attachment `ip_address` read value: %v", err)
+	}
+	if err := d.Set("mac_address", vpcInfo.MacAddress); err != nil {
+		return diag.Errorf("unable to set resource instance_vpc_attachment `mac_address` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrInstanceVPCAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	serverID, bareMetal := vpcAttachmentServerID(d)
+	vpcID := d.Get("vpc_id").(string)
+
+	log.Printf("[INFO] Detaching VPC %s from server %s", vpcID, serverID)
+
+	var err error
+	if bareMetal {
+		err = client.BareMetalServer.DetachVPC(ctx, serverID, vpcID)
+	} else {
+		err = client.Instance.DetachVPC(ctx, serverID, vpcID)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid instance ID") || strings.Contains(err.Error(), "Invalid server") {
+			return nil
+		}
+		return diag.Errorf("error detaching VPC %s from server %s: %v", vpcID, serverID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if _, err := waitForVPCAttachment(ctx, d, "detached", []string{"attached"}, timeout, meta); err != nil {
+		return diag.Errorf("error while waiting for VPC %s to detach from server %s: %v", vpcID, serverID, err)
+	}
+
+	return nil
+}
+
+func resourceVultrInstanceVPCAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	ids := strings.Split(d.Id(), "|")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		return nil, fmt.Errorf("unexpected format of VPC attachment import ID (%s): expected 'serverID|vpcID'", d.Id())
+	}
+
+	serverID, vpcID := ids[0], ids[1]
+
+	// The ID doesn't say what kind of server it refers to, so look it up
+	field := "instance_id"
+	if _, _, err := client.Instance.Get(ctx, serverID); err != nil {
+		if _, _, bmErr := client.BareMetalServer.Get(ctx, serverID); bmErr != nil {
+			return nil, fmt.Errorf("unable to find an instance or bare metal server with ID %s", serverID)
+		}
+		field = "bare_metal_server_id"
+	}
+
+	if err := d.Set(field, serverID); err != nil {
+		return nil, fmt.Errorf("unable to set `%s` for import state function", field)
+	}
+	if err := d.Set("vpc_id", vpcID); err != nil {
+		return nil, fmt.Errorf("unable to set `vpc_id` for import state function")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vpcAttachmentServerID returns the server ID of the attachment and whether it
+// is a bare metal server
+func vpcAttachmentServerID(d *schema.ResourceData) (string, bool) {
+	if id, ok := d.GetOk("bare_metal_server_id"); ok {
+		return id.(string), true
+	}
+
+	return d.Get("instance_id").(string), false
+}
+
+// getVPCAttachment returns the VPC info for vpcID on a server, or nil if the
+// VPC isn't attached
+func getVPCAttachment(ctx context.Context, client *govultr.Client, serverID, vpcID string, bareMetal bool) (*govultr.VPCInfo, error) { //nolint:lll
+	if bareMetal {
+		vpcInfo, _, err := client.BareMetalServer.ListVPCInfo(ctx, serverID)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range vpcInfo {
+			if vpcInfo[i].ID == vpcID {
+				return &vpcInfo[i], nil
+			}
+		}
+
+		return nil, nil
+	}
+
+	options := &govultr.ListOptions{}
+	for {
+		vpcInfo, meta, _, err := client.Instance.ListVPCInfo(ctx, serverID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range vpcInfo {
+			if vpcInfo[i].ID == vpcID {
+				return &vpcInfo[i], nil
+			}
+		}
+
+		if meta == nil || meta.Links == nil || meta.Links.Next == "" {
+			break
+		}
+		options.Cursor = meta.Links.Next
+	}
+
+	return nil, nil
+}
+
+func waitForVPCAttachment(ctx context.Context, d *schema.ResourceData, target string, pending []string, timeout time.Duration, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for VPC attachment (%s) to be %s", d.Id(), target)
+
+	client := meta.(*Client).govultrClient()
+	serverID, bareMetal := vpcAttachmentServerID(d)
+	vpcID := d.Get("vpc_id").(string)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			vpcInfo, err := getVPCAttachment(ctx, client, serverID, vpcID, bareMetal)
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving VPC attachment %s : %s", d.Id(), err)
+			}
+
+			if vpcInfo == nil {
+				return serverID, "detached", nil
+			}
+
+			return vpcInfo, "attached", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,107 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrInstanceVPCAttachmentBasic(t *testing.T) {
+	t.Parallel()
+
+	name := "vultr_instance_vpc_attachment.test"
+	serverLabel := acctest.RandomWithPrefix("tf-rs-vps-vpc-attach")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrInstanceVPCAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrInstanceVPCAttachment(serverLabel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVultrInstanceVPCAttachmentExists(name),
+					resource.TestCheckResourceAttrSet(name, "instance_id"),
+					resource.TestCheckResourceAttrSet(name, "vpc_id"),
+					resource.TestCheckResourceAttrSet(name, "mac_address"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVultrInstanceVPCAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("vpc attachment not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("vpc attachment ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		vpcInfo, err := getVPCAttachment(context.Background(), client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["vpc_id"], false)
+		if err != nil {
+			return err
+		}
+
+		if vpcInfo == nil {
+			return fmt.Errorf("vpc attachment does not exist: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVultrInstanceVPCAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_instance_vpc_attachment" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		vpcInfo, err := getVPCAttachment(context.Background(), client, rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["vpc_id"], false)
+		if err != nil {
+			// the instance is gone, so the attachment is too
+			return nil
+		}
+
+		if vpcInfo != nil {
+			return fmt.Errorf("vpc attachment %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccVultrInstanceVPCAttachment(serverLabel string) string {
+	return fmt.Sprintf(`
+		resource "vultr_vpc" "foo" {
+			region = "sea"
+			description = "foo"
+		}
+
+		resource "vultr_instance" "foo" {
+			plan = "vc2-1c-2gb"
+			region = "sea"
+			os_id = "167"
+			label = "%s"
+		}
+
+		resource "vultr_instance_vpc_attachment" "test" {
+			instance_id = "${vultr_instance.foo.id}"
+			vpc_id = "${vultr_vpc.foo.id}"
+		}
+	`, serverLabel)
+}
@@ -4,17 +4,45 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vultr/govultr/v3"
 )
 
 var tfVKEDefault = "tf-vke-default"
 
+// govultr's cluster requests don't include the subnet, HA and firewall
+// options, so create and control plane updates go through the authenticated
+// client directly
+const vkeClustersPath = "/v2/kubernetes/clusters"
+
+type vkeClusterCreateReq struct {
+	*govultr.ClusterReq
+	ClusterSubnet string `json:"cluster_subnet,omitempty"`
+	ServiceSubnet string `json:"service_subnet,omitempty"`
+}
+
+type vkeClusterUpdateReq struct {
+	Label           string `json:"label"`
+	HAControlPlanes *bool  `json:"ha_controlplanes,omitempty"`
+	EnableFirewall  *bool  `json:"enable_firewall,omitempty"`
+}
+
+type vkeClusterBase struct {
+	VKECluster *govultr.Cluster `json:"vke_cluster"`
+}
+
+// tfVKESurge tags a replacement default node pool while it is being brought up
+var tfVKESurge = "tf-vke-surge"
+
 func resourceVultrKubernetes() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceVultrKubernetesCreate,
@@ -24,6 +52,15 @@ func resourceVultrKubernetes() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(
+			resourceVultrKubernetesVersionDiff,
+			resourceVultrKubernetesNodePoolPlanDiff,
+			resourceVultrKubernetesNodePoolScalingDiff,
+			customdiff.ComputedIf("kube_config_rendered", resourceVultrKubernetesKubeConfigChanged),
+			customdiff.ComputedIf("firewall_group_id", resourceVultrKubernetesFirewallChanged),
+			customdiff.ForceNewIfChange("ha_controlplanes", resourceVultrKubernetesHADisabled),
+			resourceVultrKubernetesSubnetDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"label": {
 				Type:     schema.TypeString,
@@ -48,13 +85,34 @@ func resourceVultrKubernetes() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 			"enable_firewall": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
+			},
+			"cluster_subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"service_subnet": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"kube_config_context_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_linked_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"node_pools": {
@@ -71,14 +129,6 @@ func resourceVultrKubernetes() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"cluster_subnet": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"service_subnet": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 			"ip": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +145,25 @@ func resourceVultrKubernetes() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config_rendered": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"kube_config": {
 				Description: "Base64 encoded KubeConfig",
 				Type:        schema.TypeString,
@@ -142,10 +211,20 @@ func resourceVultrKubernetesCreate(ctx context.Context, d *schema.ResourceData,
 		NodePools:       nodePoolReq,
 	}
 
-	cluster, _, err := client.Kubernetes.CreateCluster(ctx, req)
+	createReq, err := client.NewRequest(ctx, http.MethodPost, vkeClustersPath, &vkeClusterCreateReq{
+		ClusterReq:    req,
+		ClusterSubnet: d.Get("cluster_subnet").(string),
+		ServiceSubnet: d.Get("service_subnet").(string),
+	})
 	if err != nil {
+		return diag.Errorf("error building kubernetes cluster request: %v", err)
+	}
+
+	created := new(vkeClusterBase)
+	if _, err := client.DoWithContext(ctx, createReq, created); err != nil {
 		return diag.Errorf("error creating kubernetes cluster: %v", err)
 	}
+	cluster := created.VKECluster
 
 	d.SetId(cluster.ID)
 
@@ -178,7 +257,18 @@ func resourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData, me
 	found := false
 	for i := range vke.NodePools {
 		if tfVKEDefault == vke.NodePools[i].Tag {
-			if err := d.Set("node_pools", flattenNodePool(&vke.NodePools[i])); err != nil {
+			nodePool := flattenNodePool(&vke.NodePools[i])
+
+			// these fields only exist in terraform
+			strategy := nodePoolReplacementReplace
+			if v, ok := d.GetOk("node_pools.0.replacement_strategy"); ok {
+				strategy = v.(string)
+			}
+			nodePool[0]["replacement_strategy"] = strategy
+			nodePool[0]["recycle_triggers"] = d.Get("node_pools.0.recycle_triggers")
+			nodePool[0]["remove_nodes"] = d.Get("node_pools.0.remove_nodes")
+
+			if err := d.Set("node_pools", nodePool); err != nil {
 				return diag.Errorf("unable to set resource kubernetes `node_pools` read value: %v", err)
 			}
 			found = true
@@ -221,22 +311,8 @@ func resourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("could not get kubeconfig : %v", err)
 	}
 
-	ca, cert, key, err := getCertsFromKubeConfig(config.KubeConfig)
-	if err != nil {
-		return diag.Errorf("error getting certs from kubeconfig : %v", err)
-	}
-
-	if err := d.Set("kube_config", config.KubeConfig); err != nil {
-		return diag.Errorf("unable to set resource kubernetes `kube_config` read value: %v", err)
-	}
-	if err := d.Set("cluster_ca_certificate", ca); err != nil {
-		return diag.Errorf("unable to set kubernetes `cluster_ca_certificate` read value: %v", err)
-	}
-	if err := d.Set("client_certificate", cert); err != nil {
-		return diag.Errorf("unable to set kubernetes `client_certificate` read value: %v", err)
-	}
-	if err := d.Set("client_key", key); err != nil {
-		return diag.Errorf("unable to set kubernetes `client_key` read value: %v", err)
+	if err := setKubeConfigAttributes(d, config.KubeConfig); err != nil {
+		return diag.Errorf("error reading kubeconfig for kubernetes cluster %s : %v", d.Id(), err)
 	}
 	if err := d.Set("version", vke.Version); err != nil {
 		return diag.Errorf("unable to set resource kubernetes `version` read value: %v", err)
@@ -244,9 +320,18 @@ func resourceVultrKubernetesRead(ctx context.Context, d *schema.ResourceData, me
 	if err := d.Set("ha_controlplanes", vke.HAControlPlanes); err != nil {
 		return diag.Errorf("unable to set resource kubernetes `ha_controlplanes` read value: %v", err)
 	}
+	// The API doesn't return enable_firewall, a managed firewall group is
+	// only present when it is enabled
+	if err := d.Set("enable_firewall", vke.FirewallGroupID != ""); err != nil {
+		return diag.Errorf("unable to set resource kubernetes `enable_firewall` read value: %v", err)
+	}
 	if err := d.Set("firewall_group_id", vke.FirewallGroupID); err != nil {
 		return diag.Errorf("unable to set resource kubernetes `firewall_group_id` read value: %v", err)
 	}
+	// delete_linked_resources only exists in terraform, this keeps it in state after import
+	if err := d.Set("delete_linked_resources", d.Get("delete_linked_resources").(bool)); err != nil {
+		return diag.Errorf("unable to set resource kubernetes `delete_linked_resources` read value: %v", err)
+	}
 
 	return nil
 }
@@ -263,12 +348,53 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChanges("ha_controlplanes", "enable_firewall") {
+		req := &vkeClusterUpdateReq{Label: d.Get("label").(string)}
+		if d.HasChange("ha_controlplanes") {
+			req.HAControlPlanes = govultr.BoolToBoolPtr(d.Get("ha_controlplanes").(bool))
+		}
+		if d.HasChange("enable_firewall") {
+			req.EnableFirewall = govultr.BoolToBoolPtr(d.Get("enable_firewall").(bool))
+		}
+
+		updateReq, err := client.NewRequest(ctx, http.MethodPut, fmt.Sprintf("%s/%s", vkeClustersPath, d.Id()), req)
+		if err != nil {
+			return diag.Errorf("error building vke cluster update request: %v", err)
+		}
+		if _, err := client.DoWithContext(ctx, updateReq, nil); err != nil {
+			return diag.Errorf("error updating vke cluster control plane (%v): %v", d.Id(), err)
+		}
+
+		if _, err := waitForVKEAvailable(ctx, d, "active", []string{"pending", "updating"}, "status", meta); err != nil {
+			return diag.Errorf("error while waiting for vke cluster %v control plane update: %v", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("node_pools") {
 		oldNP, newNP := d.GetChange("node_pools")
 
-		if len(newNP.([]interface{})) != 0 && len(oldNP.([]interface{})) != 0 {
+		if len(newNP.([]interface{})) != 0 && len(oldNP.([]interface{})) != 0 &&
+			d.HasChange("node_pools.0.plan") {
+			if err := surgeReplaceDefaultNodePool(ctx, d, meta); err != nil {
+				return diag.Errorf("error replacing VKE default node pool %v : %v", d.Id(), err)
+			}
+		} else if len(newNP.([]interface{})) != 0 && len(oldNP.([]interface{})) != 0 {
 			n := newNP.([]interface{})[0].(map[string]interface{})
 
+			if d.HasChanges("node_pools.0.recycle_triggers", "node_pools.0.remove_nodes") {
+				oldTriggers, newTriggers := d.GetChange("node_pools.0.recycle_triggers")
+				oldRemove, newRemove := d.GetChange("node_pools.0.remove_nodes")
+
+				ops := newNodePoolNodeOps(oldTriggers, newTriggers, oldRemove, newRemove)
+				oldQuantity, newQuantity := d.GetChange("node_pools.0.node_quantity")
+				if err := checkNodePoolRemoval(oldQuantity.(int), newQuantity.(int), len(ops.remove)); err != nil {
+					return diag.FromErr(err)
+				}
+				if err := applyNodePoolNodeOps(ctx, meta, d.Id(), n["id"].(string), ops); err != nil {
+					return diag.Errorf("error updating nodes in VKE node pool %v : %v", n["id"], err)
+				}
+			}
+
 			labels := make(map[string]string)
 			for k, v := range n["labels"].(map[string]interface{}) {
 				labels[k] = v.(string)
@@ -286,16 +412,19 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 
 			req := &govultr.NodePoolReqUpdate{
-				NodeQuantity: n["node_quantity"].(int),
-				AutoScaler:   govultr.BoolToBoolPtr(n["auto_scaler"].(bool)),
-				MinNodes:     n["min_nodes"].(int),
-				MaxNodes:     n["max_nodes"].(int),
+				AutoScaler: govultr.BoolToBoolPtr(n["auto_scaler"].(bool)),
+				MinNodes:   n["min_nodes"].(int),
+				MaxNodes:   n["max_nodes"].(int),
 				// Not updating tag for default node pool since it's needed to lookup in terraform
 				Labels:   labels,
 				Taints:   taints,
 				UserData: govultr.StringToStringPtr(n["user_data"].(string)),
 			}
 
+			if d.HasChange("node_pools.0.node_quantity") {
+				req.NodeQuantity = n["node_quantity"].(int)
+			}
+
 			if _, _, err := client.Kubernetes.UpdateNodePool(ctx, d.Id(), n["id"].(string), req); err != nil {
 				return diag.Errorf("error updating VKE node pool %v : %v", d.Id(), err)
 			}
@@ -354,6 +483,11 @@ func resourceVultrKubernetesUpdate(ctx context.Context, d *schema.ResourceData,
 		if err := client.Kubernetes.Upgrade(ctx, d.Id(), upgradeReq); err != nil {
 			return diag.Errorf("error upgrading VKE cluster %v : %v", d.Id(), err)
 		}
+
+		if _, err := waitForVKEUpgrade(ctx, d, upgradeReq.UpgradeVersion, meta); err != nil {
+			return diag.Errorf(
+				"error while waiting for VKE cluster %v to upgrade to %v : %v", d.Id(), upgradeReq.UpgradeVersion, err)
+		}
 	}
 
 	return resourceVultrKubernetesRead(ctx, d, meta)
@@ -364,12 +498,161 @@ func resourceVultrKubernetesDelete(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[INFO] Delete VKE : %v", d.Id())
 
+	if d.Get("delete_linked_resources").(bool) {
+		// Also removes the load balancers and block storage created by the
+		// cluster's cloud controller manager and CSI driver
+		if err := client.Kubernetes.DeleteClusterWithResources(ctx, d.Id()); err != nil {
+			return diag.Errorf("error deleting VKE %v with linked resources : %v", d.Id(), err)
+		}
+		return nil
+	}
+
 	if err := client.Kubernetes.DeleteCluster(ctx, d.Id()); err != nil {
 		return diag.Errorf("error deleting VKE %v : %v", d.Id(), err)
 	}
 	return nil
 }
 
+// resourceVultrKubernetesVersionDiff rejects version changes that aren't in
+// the cluster's list of available upgrades, so bad upgrade paths fail at plan
+// time rather than part way through an apply
+func resourceVultrKubernetesVersionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("version") || !d.NewValueKnown("version") {
+		return nil
+	}
+
+	oldVersion, newVersion := d.GetChange("version")
+	if oldVersion.(string) == "" {
+		return nil
+	}
+
+	client := meta.(*Client).govultrClient()
+	upgrades, _, err := client.Kubernetes.GetUpgrades(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("error getting available upgrades for VKE cluster %s: %v", d.Id(), err)
+	}
+
+	for _, v := range upgrades {
+		if v == newVersion.(string) {
+			return nil
+		}
+	}
+
+	if len(upgrades) == 0 {
+		return fmt.Errorf("VKE cluster %s cannot be upgraded from %s to %s: no upgrades are available",
+			d.Id(), oldVersion, newVersion)
+	}
+
+	return fmt.Errorf("VKE cluster %s cannot be upgraded from %s to %s, available upgrades are: %s",
+		d.Id(), oldVersion, newVersion, strings.Join(filterVKEVersions(upgrades, ""), ", "))
+}
+
+// resourceVultrKubernetesNodePoolPlanDiff rejects plan changes on the default
+// node pool unless they can be rolled out with a surge replacement. The
+// cluster can't be left without its default pool, so there is no
+// destroy-then-create fallback.
+func resourceVultrKubernetesNodePoolPlanDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("node_pools.0.plan") {
+		return nil
+	}
+
+	oldPlan, newPlan := d.GetChange("node_pools.0.plan")
+	if oldPlan.(string) == "" || newPlan.(string) == "" {
+		return nil
+	}
+
+	if d.Get("node_pools.0.replacement_strategy").(string) != nodePoolReplacementSurge {
+		return fmt.Errorf(
+			"changing the plan of the default node pool from %s to %s requires replacement_strategy = %q",
+			oldPlan, newPlan, nodePoolReplacementSurge)
+	}
+
+	return nil
+}
+
+func resourceVultrKubernetesNodePoolScalingDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if len(d.Get("node_pools").([]interface{})) == 0 {
+		return nil
+	}
+
+	if err := validateNodePoolScaling(d, "node_pools.0.", "node_pools", "node_quantity"); err != nil {
+		return err
+	}
+
+	return validateNodePoolRemoval(d, "node_pools.0.")
+}
+
+// resourceVultrKubernetesKubeConfigChanged marks the rendered kubeconfig as
+// unknown when the context name it is generated with changes
+func resourceVultrKubernetesKubeConfigChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.HasChange("kube_config_context_name")
+}
+
+// resourceVultrKubernetesFirewallChanged marks the managed firewall group as
+// unknown when the firewall is toggled, so rules that reference it are planned
+// against the new group
+func resourceVultrKubernetesFirewallChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.HasChange("enable_firewall")
+}
+
+// resourceVultrKubernetesHADisabled reports whether HA control planes are
+// being turned off, which the API only supports by rebuilding the cluster
+func resourceVultrKubernetesHADisabled(ctx context.Context, old, new, meta interface{}) bool {
+	return old.(bool) && !new.(bool)
+}
+
+// resourceVultrKubernetesSubnetDiff checks that the pod and service subnets
+// don't overlap each other or the subnet of the cluster's VPC
+func resourceVultrKubernetesSubnetDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("cluster_subnet", "service_subnet", "vpc_id") {
+		return nil
+	}
+
+	subnets := map[string]*net.IPNet{}
+	for _, key := range []string{"cluster_subnet", "service_subnet"} {
+		if !d.NewValueKnown(key) || d.Get(key).(string) == "" {
+			continue
+		}
+		_, subnet, err := net.ParseCIDR(d.Get(key).(string))
+		if err != nil {
+			return fmt.Errorf("%s is not a valid CIDR: %v", key, err)
+		}
+		subnets[key] = subnet
+	}
+
+	if cs, ss := subnets["cluster_subnet"], subnets["service_subnet"]; cs != nil && ss != nil && subnetsOverlap(cs, ss) {
+		return fmt.Errorf("cluster_subnet %s overlaps service_subnet %s", cs, ss)
+	}
+
+	if len(subnets) == 0 || !d.NewValueKnown("vpc_id") || d.Get("vpc_id").(string) == "" {
+		return nil
+	}
+
+	client := meta.(*Client).govultrClient()
+	vpc, _, err := client.VPC.Get(ctx, d.Get("vpc_id").(string))
+	if err != nil {
+		return fmt.Errorf("error getting VPC %s to check subnets against: %v", d.Get("vpc_id"), err)
+	}
+
+	_, vpcSubnet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", vpc.V4Subnet, vpc.V4SubnetMask))
+	if err != nil {
+		return fmt.Errorf("error parsing subnet of VPC %s: %v", vpc.ID, err)
+	}
+
+	for _, key := range []string{"cluster_subnet", "service_subnet"} {
+		if subnet := subnets[key]; subnet != nil && subnetsOverlap(subnet, vpcSubnet) {
+			return fmt.Errorf("%s %s overlaps the subnet %s of VPC %s", key, subnet, vpcSubnet, vpc.ID)
+		}
+	}
+
+	return nil
+}
+
+// subnetsOverlap reports whether two networks share any addresses
+func subnetsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
 func generateNodePool(pools interface{}) []govultr.NodePoolReq {
 	var npr []govultr.NodePoolReq
 	pool := pools.([]interface{})
@@ -448,6 +731,77 @@ func newVKEStateRefresh(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 }
 
+// surgeReplaceDefaultNodePool swaps the default node pool for one on the new
+// plan. The replacement is tagged separately until the old pool is gone so a
+// failure part way through never leaves two pools claiming to be the default.
+func surgeReplaceDefaultNodePool(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).govultrClient()
+
+	oldNP, newNP := d.GetChange("node_pools")
+	oldID := oldNP.([]interface{})[0].(map[string]interface{})["id"].(string)
+
+	req := generateNodePool(newNP)[0]
+	req.Tag = tfVKESurge
+
+	newID, err := surgeReplaceNodePool(ctx, meta, d.Id(), oldID, &req)
+	if err != nil {
+		return err
+	}
+
+	retag := &govultr.NodePoolReqUpdate{
+		Tag:    govultr.StringToStringPtr(tfVKEDefault),
+		Labels: req.Labels,
+		Taints: req.Taints,
+	}
+	if _, _, err := client.Kubernetes.UpdateNodePool(ctx, d.Id(), newID, retag); err != nil {
+		return fmt.Errorf("error tagging node pool %s as the default node pool: %v", newID, err)
+	}
+
+	return nil
+}
+
+// waitForVKEUpgrade blocks until the cluster reports active on version with
+// every node in every node pool active
+func waitForVKEUpgrade(ctx context.Context, d *schema.ResourceData, version string, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for kubernetes cluster (%s) to upgrade to %s", d.Id(), version)
+
+	client := meta.(*Client).govultrClient()
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"upgrading"},
+		Target:  []string{"upgraded"},
+		Refresh: func() (interface{}, string, error) {
+			vke, _, err := client.Kubernetes.GetCluster(ctx, d.Id())
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving kubernetes cluster %s ", d.Id())
+			}
+
+			if vke.Status != "active" || vke.Version != version {
+				log.Printf("[INFO] The kubernetes cluster is %v on version %v", vke.Status, vke.Version)
+				return vke, "upgrading", nil
+			}
+
+			for i := range vke.NodePools {
+				for _, n := range vke.NodePools[i].Nodes {
+					if n.Status != "active" {
+						log.Printf("[INFO] Node %v in node pool %v is %v", n.ID, vke.NodePools[i].ID, n.Status)
+						return vke, "upgrading", nil
+					}
+				}
+			}
+
+			return vke, "upgraded", nil
+		},
+		Timeout:    60 * time.Minute,
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+		// The control plane can briefly report active on the new version
+		// before node replacement begins
+		ContinuousTargetOccurence: 3,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
 func flattenNodePool(np *govultr.NodePool) []map[string]interface{} {
 	var nodePools []map[string]interface{}
 
@@ -477,21 +831,22 @@ func flattenNodePool(np *govultr.NodePool) []map[string]interface{} {
 	}
 
 	pool := map[string]interface{}{
-		"label":         np.Label,
-		"plan":          np.Plan,
-		"node_quantity": np.NodeQuantity,
-		"id":            np.ID,
-		"date_created":  np.DateCreated,
-		"date_updated":  np.DateUpdated,
-		"status":        np.Status,
-		"tag":           np.Tag,
-		"nodes":         instances,
-		"auto_scaler":   np.AutoScaler,
-		"min_nodes":     np.MinNodes,
-		"max_nodes":     np.MaxNodes,
-		"labels":        labels,
-		"taints":        taints,
-		"user_data":     np.UserData,
+		"label":                 np.Label,
+		"plan":                  np.Plan,
+		"node_quantity":         np.NodeQuantity,
+		"current_node_quantity": len(np.Nodes),
+		"id":                    np.ID,
+		"date_created":          np.DateCreated,
+		"date_updated":          np.DateUpdated,
+		"status":                np.Status,
+		"tag":                   np.Tag,
+		"nodes":                 instances,
+		"auto_scaler":           np.AutoScaler,
+		"min_nodes":             np.MinNodes,
+		"max_nodes":             np.MaxNodes,
+		"labels":                labels,
+		"taints":                taints,
+		"user_data":             np.UserData,
 	}
 
 	nodePools = append(nodePools, pool)
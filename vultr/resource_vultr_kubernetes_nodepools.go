@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -35,7 +36,8 @@ func resourceVultrKubernetesNodePools() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
-		Schema: nodePoolSchema(true),
+		Schema:        nodePoolSchema(true),
+		CustomizeDiff: resourceVultrKubernetesNodePoolsCustomizeDiff,
 	}
 }
 
@@ -44,40 +46,7 @@ func resourceVultrKubernetesNodePoolsCreate(ctx context.Context, d *schema.Resou
 
 	clusterID := d.Get("cluster_id").(string)
 
-	req := &govultr.NodePoolReq{
-		NodeQuantity: d.Get("node_quantity").(int),
-		Label:        d.Get("label").(string),
-		Plan:         d.Get("plan").(string),
-		Tag:          d.Get("tag").(string),
-		AutoScaler:   govultr.BoolToBoolPtr(d.Get("auto_scaler").(bool)),
-		MinNodes:     d.Get("min_nodes").(int),
-		MaxNodes:     d.Get("max_nodes").(int),
-		UserData:     d.Get("user_data").(string),
-	}
-
-	if labelsVal, labelsOK := d.GetOk("labels"); labelsOK {
-		labels := make(map[string]string)
-		for k, v := range labelsVal.(map[string]interface{}) {
-			labels[k] = v.(string)
-		}
-
-		req.Labels = labels
-	}
-
-	if taintsVal, taintsOK := d.GetOk("taints"); taintsOK {
-		var taints []govultr.Taint
-		taintVals := taintsVal.(*schema.Set).List()
-		for i := range taintVals {
-			taint := taintVals[i].(map[string]interface{})
-			taints = append(taints, govultr.Taint{
-				Key:    taint["key"].(string),
-				Value:  taint["value"].(string),
-				Effect: taint["effect"].(string),
-			})
-		}
-
-		req.Taints = taints
-	}
+	req := buildNodePoolReq(d)
 
 	nodePool, _, err := client.Kubernetes.CreateNodePool(ctx, clusterID, req)
 	if err != nil {
@@ -131,6 +100,9 @@ func resourceVultrKubernetesNodePoolsRead(ctx context.Context, d *schema.Resourc
 	if err := d.Set("node_quantity", nodePool.NodeQuantity); err != nil {
 		return diag.Errorf("unable to set resource kubernetes_nodepools `node_quantity` read value: %v", err)
 	}
+	if err := d.Set("current_node_quantity", len(nodePool.Nodes)); err != nil {
+		return diag.Errorf("unable to set resource kubernetes_nodepools `current_node_quantity` read value: %v", err)
+	}
 	if err := d.Set("date_created", nodePool.DateCreated); err != nil {
 		return diag.Errorf("unable to set resource kubernetes_nodepools `date_created` read value: %v", err)
 	}
@@ -178,6 +150,13 @@ func resourceVultrKubernetesNodePoolsRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("unable to set resource kubernetes_nodepools `nodes` read value: %v", err)
 	}
 
+	// replacement_strategy only exists in terraform, so fill in the default on import
+	if _, ok := d.GetOk("replacement_strategy"); !ok {
+		if err := d.Set("replacement_strategy", nodePoolReplacementReplace); err != nil {
+			return diag.Errorf("unable to set resource kubernetes_nodepools `replacement_strategy` read value: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -186,12 +165,45 @@ func resourceVultrKubernetesNodePoolsUpdate(ctx context.Context, d *schema.Resou
 
 	clusterID := d.Get("cluster_id").(string)
 
+	// Plan changes only reach update with the surge strategy, otherwise the
+	// diff forces a new resource. The new pool is built from the full config,
+	// so nothing else needs updating afterwards.
+	if d.HasChange("plan") {
+		oldID := d.Id()
+		newID, err := surgeReplaceNodePool(ctx, meta, clusterID, oldID, buildNodePoolReq(d))
+		if err != nil {
+			return diag.Errorf("error replacing VKE node pool %v : %v", oldID, err)
+		}
+		d.SetId(newID)
+
+		return resourceVultrKubernetesNodePoolsRead(ctx, d, meta)
+	}
+
+	// Targeted node operations go first so a node_quantity change in the same
+	// apply doesn't scale down a different node than the one being removed
+	if d.HasChanges("recycle_triggers", "remove_nodes") {
+		oldTriggers, newTriggers := d.GetChange("recycle_triggers")
+		oldRemove, newRemove := d.GetChange("remove_nodes")
+
+		ops := newNodePoolNodeOps(oldTriggers, newTriggers, oldRemove, newRemove)
+		oldQuantity, newQuantity := d.GetChange("node_quantity")
+		if err := checkNodePoolRemoval(oldQuantity.(int), newQuantity.(int), len(ops.remove)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := applyNodePoolNodeOps(ctx, meta, clusterID, d.Id(), ops); err != nil {
+			return diag.Errorf("error updating nodes in VKE node pool %v : %v", d.Id(), err)
+		}
+	}
+
 	req := &govultr.NodePoolReqUpdate{
-		NodeQuantity: d.Get("node_quantity").(int),
-		Tag:          govultr.StringToStringPtr(d.Get("tag").(string)),
-		AutoScaler:   govultr.BoolToBoolPtr(d.Get("auto_scaler").(bool)),
-		MinNodes:     d.Get("min_nodes").(int),
-		MaxNodes:     d.Get("max_nodes").(int),
+		Tag:        govultr.StringToStringPtr(d.Get("tag").(string)),
+		AutoScaler: govultr.BoolToBoolPtr(d.Get("auto_scaler").(bool)),
+		MinNodes:   d.Get("min_nodes").(int),
+		MaxNodes:   d.Get("max_nodes").(int),
+	}
+
+	if d.HasChange("node_quantity") {
+		req.NodeQuantity = d.Get("node_quantity").(int)
 	}
 
 	if d.HasChange("labels") {
@@ -241,6 +253,316 @@ func resourceVultrKubernetesNodePoolsDelete(ctx context.Context, d *schema.Resou
 	return nil
 }
 
+func resourceVultrKubernetesNodePoolsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error { //nolint:lll
+	if err := validateNodePoolScaling(d, "", "node_quantity"); err != nil {
+		return err
+	}
+
+	if err := validateNodePoolRemoval(d, ""); err != nil {
+		return err
+	}
+
+	if d.Id() == "" || !d.HasChange("plan") {
+		return nil
+	}
+
+	if d.Get("replacement_strategy").(string) != nodePoolReplacementSurge {
+		return d.ForceNew("plan")
+	}
+
+	return nil
+}
+
+func buildNodePoolReq(d *schema.ResourceData) *govultr.NodePoolReq {
+	req := &govultr.NodePoolReq{
+		NodeQuantity: d.Get("node_quantity").(int),
+		Label:        d.Get("label").(string),
+		Plan:         d.Get("plan").(string),
+		Tag:          d.Get("tag").(string),
+		AutoScaler:   govultr.BoolToBoolPtr(d.Get("auto_scaler").(bool)),
+		MinNodes:     d.Get("min_nodes").(int),
+		MaxNodes:     d.Get("max_nodes").(int),
+		UserData:     d.Get("user_data").(string),
+	}
+
+	if labelsVal, labelsOK := d.GetOk("labels"); labelsOK {
+		labels := make(map[string]string)
+		for k, v := range labelsVal.(map[string]interface{}) {
+			labels[k] = v.(string)
+		}
+
+		req.Labels = labels
+	}
+
+	if taintsVal, taintsOK := d.GetOk("taints"); taintsOK {
+		var taints []govultr.Taint
+		taintVals := taintsVal.(*schema.Set).List()
+		for i := range taintVals {
+			taint := taintVals[i].(map[string]interface{})
+			taints = append(taints, govultr.Taint{
+				Key:    taint["key"].(string),
+				Value:  taint["value"].(string),
+				Effect: taint["effect"].(string),
+			})
+		}
+
+		req.Taints = taints
+	}
+
+	return req
+}
+
+// surgeReplaceNodePool creates a node pool from req, waits for all of its
+// nodes to be active and then deletes the old node pool, so the cluster never
+// runs with less capacity than before. It returns the ID of the new pool. On
+// failure the new pool is removed again and the old one is left in place.
+func surgeReplaceNodePool(ctx context.Context, meta interface{}, clusterID, oldID string, req *govultr.NodePoolReq) (string, error) { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	log.Printf("[INFO] Creating replacement for VKE node pool %s with plan %s", oldID, req.Plan)
+	nodePool, _, err := client.Kubernetes.CreateNodePool(ctx, clusterID, req)
+	if err != nil {
+		return "", fmt.Errorf("error creating replacement node pool: %v", err)
+	}
+
+	if _, err := waitForNodePoolNodesActive(ctx, clusterID, nodePool.ID, meta); err != nil {
+		// Leave the old pool serving and clean up the one that never came up
+		if delErr := client.Kubernetes.DeleteNodePool(ctx, clusterID, nodePool.ID); delErr != nil {
+			return "", fmt.Errorf(
+				"error while waiting for replacement node pool %s: %v (the replacement could not be removed: %v)",
+				nodePool.ID, err, delErr)
+		}
+		return "", fmt.Errorf("error while waiting for replacement node pool %s: %v", nodePool.ID, err)
+	}
+
+	log.Printf("[INFO] Deleting VKE node pool %s replaced by %s", oldID, nodePool.ID)
+	if err := client.Kubernetes.DeleteNodePool(ctx, clusterID, oldID); err != nil {
+		err = fmt.Errorf("error deleting node pool %s after replacing it with %s: %v", oldID, nodePool.ID, err)
+
+		// The old pool is still the one in state, so remove the replacement
+		// rather than leaking one per apply. The next apply starts over.
+		log.Printf("[INFO] Deleting replacement node pool %s as node pool %s could not be deleted", nodePool.ID, oldID)
+		if delErr := client.Kubernetes.DeleteNodePool(ctx, clusterID, nodePool.ID); delErr != nil {
+			return "", fmt.Errorf("%v (the replacement node pool %s could not be removed: %v)", err, nodePool.ID, delErr)
+		}
+		return "", err
+	}
+
+	return nodePool.ID, nil
+}
+
+// waitForNodePoolNodesActive blocks until a node pool and every one of its
+// nodes are active
+func waitForNodePoolNodesActive(ctx context.Context, clusterID, nodePoolID string, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for the nodes in node pool (%s) to be active", nodePoolID)
+
+	client := meta.(*Client).govultrClient()
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			np, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, nodePoolID)
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving node pool %s ", nodePoolID)
+			}
+
+			if np.Status != "active" || len(np.Nodes) < np.NodeQuantity {
+				log.Printf("[INFO] The node pool status is %v with %d of %d nodes", np.Status, len(np.Nodes), np.NodeQuantity)
+				return np, "pending", nil
+			}
+
+			for _, n := range np.Nodes {
+				if n.Status != "active" {
+					log.Printf("[INFO] Node %v in node pool %v is %v", n.ID, np.ID, n.Status)
+					return np, "pending", nil
+				}
+			}
+
+			return np, "active", nil
+		},
+		Timeout:        60 * time.Minute,
+		Delay:          10 * time.Second,
+		MinTimeout:     5 * time.Second,
+		NotFoundChecks: 60,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// nodePoolNodeOps holds the node IDs to recycle and remove in a node pool
+type nodePoolNodeOps struct {
+	recycle []string
+	remove  []string
+}
+
+// newNodePoolNodeOps works out the node operations for a change to
+// recycle_triggers and remove_nodes. A node is recycled when its trigger is
+// added or changed, and removed when it is added to remove_nodes.
+func newNodePoolNodeOps(oldTriggers, newTriggers, oldRemove, newRemove interface{}) nodePoolNodeOps {
+	var ops nodePoolNodeOps
+
+	removed := newRemove.(*schema.Set).Difference(oldRemove.(*schema.Set))
+	for _, id := range removed.List() {
+		ops.remove = append(ops.remove, id.(string))
+	}
+
+	old := oldTriggers.(map[string]interface{})
+	for id, trigger := range newTriggers.(map[string]interface{}) {
+		if removed.Contains(id) {
+			continue
+		}
+		if prev, ok := old[id]; ok && prev == trigger {
+			continue
+		}
+		ops.recycle = append(ops.recycle, id)
+	}
+	sort.Strings(ops.recycle)
+
+	return ops
+}
+
+// checkNodePoolRemoval makes sure node_quantity is lowered along with the
+// nodes removed through remove_nodes. Removed nodes are deleted from the pool
+// for good, so a node_quantity left unchanged would provision them again.
+func checkNodePoolRemoval(oldQuantity, newQuantity, removed int) error {
+	if removed == 0 || newQuantity <= oldQuantity-removed {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"node_quantity must be lowered from %d to %d or less when removing %d node(s) through remove_nodes, got %d",
+		oldQuantity, oldQuantity-removed, removed, newQuantity)
+}
+
+// validateNodePoolRemoval runs checkNodePoolRemoval at plan time once the
+// node IDs in remove_nodes are known. Plan changes replace the whole pool, so
+// remove_nodes isn't applied to it.
+func validateNodePoolRemoval(d *schema.ResourceDiff, prefix string) error {
+	if d.Id() == "" || !d.HasChange(prefix+"remove_nodes") || d.HasChange(prefix+"plan") {
+		return nil
+	}
+	if !d.NewValueKnown(prefix+"remove_nodes") || !d.NewValueKnown(prefix+"node_quantity") {
+		return nil
+	}
+
+	oldRemove, newRemove := d.GetChange(prefix + "remove_nodes")
+	removed := newRemove.(*schema.Set).Difference(oldRemove.(*schema.Set)).Len()
+
+	oldQuantity, newQuantity := d.GetChange(prefix + "node_quantity")
+	return checkNodePoolRemoval(oldQuantity.(int), newQuantity.(int), removed)
+}
+
+// applyNodePoolNodeOps removes and recycles nodes, then waits for the node
+// pool to settle with the replacements active
+func applyNodePoolNodeOps(ctx context.Context, meta interface{}, clusterID, nodePoolID string, ops nodePoolNodeOps) error { //nolint:lll
+	if len(ops.recycle) == 0 && len(ops.remove) == 0 {
+		return nil
+	}
+
+	client := meta.(*Client).govultrClient()
+
+	np, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, nodePoolID)
+	if err != nil {
+		return fmt.Errorf("error getting node pool %s: %v", nodePoolID, err)
+	}
+
+	nodes := make(map[string]govultr.Node, len(np.Nodes))
+	for _, n := range np.Nodes {
+		nodes[n.ID] = n
+	}
+
+	for _, id := range append(append([]string{}, ops.remove...), ops.recycle...) {
+		if _, ok := nodes[id]; !ok {
+			return fmt.Errorf("node %s is not in node pool %s", id, nodePoolID)
+		}
+	}
+
+	for _, id := range ops.remove {
+		log.Printf("[INFO] Removing node %s from VKE node pool %s", id, nodePoolID)
+		if err := client.Kubernetes.DeleteNodePoolInstance(ctx, clusterID, nodePoolID, id); err != nil {
+			return fmt.Errorf("error removing node %s: %v", id, err)
+		}
+	}
+
+	recycled := make(map[string]govultr.Node, len(ops.recycle))
+	for _, id := range ops.recycle {
+		log.Printf("[INFO] Recycling node %s in VKE node pool %s", id, nodePoolID)
+		if err := client.Kubernetes.RecycleNodePoolInstance(ctx, clusterID, nodePoolID, id); err != nil {
+			return fmt.Errorf("error recycling node %s: %v", id, err)
+		}
+		recycled[id] = nodes[id]
+	}
+
+	if _, err := waitForNodePoolNodeOps(ctx, clusterID, nodePoolID, ops.remove, recycled, meta); err != nil {
+		return fmt.Errorf("error while waiting for node pool %s to replace nodes: %v", nodePoolID, err)
+	}
+
+	return nil
+}
+
+// waitForNodePoolNodeOps blocks until removed nodes are gone from the pool,
+// recycled nodes have been redeployed and every node in the pool is active.
+// A recycled node counts as redeployed once it disappears, comes back with a
+// new creation date, or has been seen leaving the active state.
+func waitForNodePoolNodeOps(ctx context.Context, clusterID, nodePoolID string, removed []string, recycled map[string]govultr.Node, meta interface{}) (interface{}, error) { //nolint:lll
+	log.Printf("[INFO] Waiting for node pool (%s) to replace nodes", nodePoolID)
+
+	client := meta.(*Client).govultrClient()
+	seenInactive := make(map[string]bool, len(recycled))
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			np, _, err := client.Kubernetes.GetNodePool(ctx, clusterID, nodePoolID)
+			if err != nil {
+				return nil, "", fmt.Errorf("error retrieving node pool %s ", nodePoolID)
+			}
+
+			current := make(map[string]govultr.Node, len(np.Nodes))
+			for _, n := range np.Nodes {
+				current[n.ID] = n
+				if _, ok := recycled[n.ID]; ok && n.Status != "active" {
+					seenInactive[n.ID] = true
+				}
+			}
+
+			for _, id := range removed {
+				if _, ok := current[id]; ok {
+					log.Printf("[INFO] Node %v is still in node pool %v", id, nodePoolID)
+					return np, "pending", nil
+				}
+			}
+
+			for id, before := range recycled {
+				n, ok := current[id]
+				if ok && n.DateCreated == before.DateCreated && !seenInactive[id] {
+					log.Printf("[INFO] Node %v in node pool %v has not been recycled yet", id, nodePoolID)
+					return np, "pending", nil
+				}
+			}
+
+			if np.Status != "active" {
+				return np, "pending", nil
+			}
+
+			for _, n := range np.Nodes {
+				if n.Status != "active" {
+					log.Printf("[INFO] Node %v in node pool %v is %v", n.ID, nodePoolID, n.Status)
+					return np, "pending", nil
+				}
+			}
+
+			return np, "active", nil
+		},
+		Timeout:                   60 * time.Minute,
+		Delay:                     10 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
 func waitForNodePoolAvailable(ctx context.Context, d *schema.ResourceData, target string, pending []string, attribute string, meta interface{}) (interface{}, error) { //nolint:lll
 	log.Printf(
 		"[INFO] Waiting for node pool (%s) to have %s of %s",
@@ -2,10 +2,12 @@ package vultr
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccResourceVultrKubernetesNodePools(t *testing.T) {
@@ -100,6 +102,63 @@ func TestAccResourceVultrKubernetesNodePoolsUpdateAutoScaler(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "auto_scaler", "false"),
 					resource.TestCheckResourceAttr(name, "min_nodes", "3"),
 					resource.TestCheckResourceAttr(name, "max_nodes", "5"),
+					resource.TestCheckResourceAttr(name, "current_node_quantity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceVultrKubernetesNodePoolsInvalidScaling(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-vke-rs")
+	rNP := acctest.RandomWithPrefix("tf-vke-np")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsInvalidScaling(rNP),
+				ExpectError: regexp.MustCompile(`node_quantity \(5\) must be between min_nodes \(1\) and max_nodes \(3\)`),
+			},
+		},
+	})
+}
+
+func TestAccResourceVultrKubernetesNodePoolsSurge(t *testing.T) {
+	skipCI(t)
+	rLabel := acctest.RandomWithPrefix("tf-vke-rs")
+	rNP := acctest.RandomWithPrefix("tf-vke-np")
+
+	var oldID string
+	name := "vultr_kubernetes_node_pools.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsSurge(rNP, "vc2-2c-4gb"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "plan", "vc2-2c-4gb"),
+					resource.TestCheckResourceAttr(name, "replacement_strategy", "surge"),
+					func(s *terraform.State) error {
+						oldID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsSurge(rNP, "vc2-4c-8gb"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "plan", "vc2-4c-8gb"),
+					resource.TestCheckResourceAttr(name, "nodes.#", "1"),
+					resource.TestCheckResourceAttr(name, "nodes.0.status", "active"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[name].Primary.ID == oldID {
+							return fmt.Errorf("expected node pool %s to be replaced", oldID)
+						}
+						return nil
+					},
 				),
 			},
 		},
@@ -144,3 +203,172 @@ func testAccVultrKubernetesNodePoolsUpdateAutoScaler(label string) string {
 				max_nodes = 5
 		}`, label)
 }
+
+func testAccVultrKubernetesNodePoolsSurge(label, plan string) string {
+	return fmt.Sprintf(`
+		resource "vultr_kubernetes_node_pools" "foo" {
+			cluster_id = vultr_kubernetes.foo.id
+			node_quantity = 1
+			plan = "%s"
+			label = "%s"
+			tag = "test23"
+			replacement_strategy = "surge"
+		}`, plan, label)
+}
+
+func TestAccResourceVultrKubernetesNodePoolsRecycle(t *testing.T) {
+	skipCI(t)
+	rLabel := acctest.RandomWithPrefix("tf-vke-rs")
+	rNP := acctest.RandomWithPrefix("tf-vke-np")
+
+	name := "vultr_kubernetes_node_pools.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsBase(rNP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "nodes.#", "1"),
+				),
+			},
+			{
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsRecycle(rLabel, rNP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "recycle_triggers.%", "1"),
+					resource.TestCheckResourceAttr(name, "nodes.#", "1"),
+					resource.TestCheckResourceAttr(name, "nodes.0.status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceVultrKubernetesNodePoolsRemoveNodes(t *testing.T) {
+	skipCI(t)
+	rLabel := acctest.RandomWithPrefix("tf-vke-rs")
+	rNP := acctest.RandomWithPrefix("tf-vke-np")
+
+	name := "vultr_kubernetes_node_pools.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsRemoveNodes(rLabel, rNP, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "node_quantity", "2"),
+					resource.TestCheckResourceAttr(name, "nodes.#", "2"),
+				),
+			},
+			{
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsRemoveNodes(rLabel, rNP, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "remove_nodes.#", "1"),
+					resource.TestCheckResourceAttr(name, "node_quantity", "1"),
+					resource.TestCheckResourceAttr(name, "current_node_quantity", "1"),
+					resource.TestCheckResourceAttr(name, "nodes.#", "1"),
+				),
+			},
+			{
+				Config:   testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsRemoveNodes(rLabel, rNP, true),
+				PlanOnly: true,
+			},
+			{
+				// shortening remove_nodes doesn't grow the pool back
+				Config: testAccVultrKubernetesBase(rLabel) + testAccVultrKubernetesNodePoolsRemoveNodesCleared(rNP),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "remove_nodes.#", "0"),
+					resource.TestCheckResourceAttr(name, "node_quantity", "1"),
+					resource.TestCheckResourceAttr(name, "nodes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccVultrKubernetesNodePoolsRemoveNodes removes the first node of the
+// pool when remove is set. The node ID is looked up through the cluster data
+// source once the pool exists and pinned with a terraform_data resource, so
+// later reads of the shrunk pool don't pick a different node.
+func testAccVultrKubernetesNodePoolsRemoveNodes(clusterLabel, label string, remove bool) string {
+	pool := `
+		resource "vultr_kubernetes_node_pools" "foo" {
+			cluster_id = vultr_kubernetes.foo.id
+			node_quantity = %d
+			plan = "vc2-2c-4gb"
+			label = "%s"
+			tag = "test23"
+			remove_nodes = %s
+		}`
+	if !remove {
+		return fmt.Sprintf(pool, 2, label, "[]")
+	}
+
+	return fmt.Sprintf(`
+		data "vultr_kubernetes" "foo" {
+			filter {
+				name = "label"
+				values = ["%s"]
+			}
+			depends_on = [vultr_kubernetes.foo]
+		}
+
+		resource "terraform_data" "first_node" {
+			input = one([for p in data.vultr_kubernetes.foo.node_pools : p.nodes[0].id if p.label == "%s"])
+
+			lifecycle {
+				ignore_changes = [input]
+			}
+		}`, clusterLabel, label) + fmt.Sprintf(pool, 1, label, "[terraform_data.first_node.output]")
+}
+
+func testAccVultrKubernetesNodePoolsRemoveNodesCleared(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_kubernetes_node_pools" "foo" {
+			cluster_id = vultr_kubernetes.foo.id
+			node_quantity = 1
+			plan = "vc2-2c-4gb"
+			label = "%s"
+			tag = "test23"
+			remove_nodes = []
+		}`, label)
+}
+
+// testAccVultrKubernetesNodePoolsRecycle looks the pool's nodes up through the
+// cluster data source, since a resource can't key its triggers off itself
+func testAccVultrKubernetesNodePoolsRecycle(clusterLabel, label string) string {
+	return fmt.Sprintf(`
+		data "vultr_kubernetes" "foo" {
+			filter {
+				name = "label"
+				values = ["%s"]
+			}
+			depends_on = [vultr_kubernetes.foo]
+		}
+
+		resource "vultr_kubernetes_node_pools" "foo" {
+			cluster_id = vultr_kubernetes.foo.id
+			node_quantity = 1
+			plan = "vc2-2c-4gb"
+			label = "%s"
+			tag = "test23"
+
+			recycle_triggers = {
+				for p in data.vultr_kubernetes.foo.node_pools : p.nodes[0].id => "1" if p.label == "%s"
+			}
+		}`, clusterLabel, label, label)
+}
+
+func testAccVultrKubernetesNodePoolsInvalidScaling(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_kubernetes_node_pools" "foo" {
+			cluster_id = vultr_kubernetes.foo.id
+			node_quantity = 5
+			plan = "vc2-2c-4gb"
+			label = "%s"
+			auto_scaler = true
+			min_nodes = 1
+			max_nodes = 3
+		}`, label)
+}
@@ -2,6 +2,7 @@ package vultr
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -27,6 +28,14 @@ func TestAccResourceVultrKubernetes(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "node_pools.0.node_quantity", "1"),
 					resource.TestCheckResourceAttr(name, "node_pools.0.plan", "vc2-2c-4gb"),
 					resource.TestCheckResourceAttr(name, "node_pools.0.label", "tf-test-label"),
+					resource.TestMatchResourceAttr(name, "host", regexp.MustCompile(`^https://`)),
+					resource.TestCheckResourceAttrSet(name, "kube_config_raw"),
+				),
+			},
+			{
+				Config: testAccVultrKubernetesContextName(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(name, "kube_config_rendered", regexp.MustCompile(`current-context: tf-test-context`)),
 				),
 			},
 		},
@@ -105,3 +114,113 @@ func testAccVultrKubernetesUpdate(label string) string {
 			}
 		}`, label)
 }
+
+func testAccVultrKubernetesContextName(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_kubernetes" "foo" {
+			region = "ewr"
+			label = "%s"
+			version = "v1.26.2+2"
+			kube_config_context_name = "tf-test-context"
+
+			node_pools {
+				node_quantity = 1
+				plan = "vc2-2c-4gb"
+				label = "tf-test-label"
+			}
+		}`, label)
+}
+
+func TestAccResourceVultrKubernetesControlPlane(t *testing.T) {
+	skipCI(t)
+	rLabel := acctest.RandomWithPrefix("tf-vke-rs-")
+
+	name := "vultr_kubernetes.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrKubernetesControlPlane(rLabel, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "cluster_subnet", "10.244.0.0/16"),
+					resource.TestCheckResourceAttr(name, "service_subnet", "10.96.0.0/12"),
+					resource.TestCheckResourceAttr(name, "enable_firewall", "false"),
+					resource.TestCheckResourceAttr(name, "firewall_group_id", ""),
+				),
+			},
+			{
+				Config: testAccVultrKubernetesControlPlane(rLabel, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enable_firewall", "true"),
+					resource.TestCheckResourceAttrSet(name, "firewall_group_id"),
+					resource.TestCheckResourceAttrPair(
+						"vultr_firewall_rule.api", "firewall_group_id",
+						name, "firewall_group_id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceVultrKubernetesSubnetOverlap(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-vke-rs-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "vultr_kubernetes" "foo" {
+						region = "ewr"
+						label = "%s"
+						version = "v1.26.2+2"
+						cluster_subnet = "10.96.0.0/16"
+						service_subnet = "10.96.0.0/12"
+
+						node_pools {
+							node_quantity = 1
+							plan = "vc2-2c-4gb"
+							label = "tf-test-label"
+						}
+					}`, rLabel),
+				ExpectError: regexp.MustCompile(`cluster_subnet 10.96.0.0/16 overlaps service_subnet 10.96.0.0/12`),
+			},
+		},
+	})
+}
+
+func testAccVultrKubernetesControlPlane(label string, firewall bool) string {
+	config := fmt.Sprintf(`
+		resource "vultr_kubernetes" "foo" {
+			region = "ewr"
+			label = "%s"
+			version = "v1.26.2+2"
+			cluster_subnet = "10.244.0.0/16"
+			service_subnet = "10.96.0.0/12"
+			enable_firewall = %t
+
+			node_pools {
+				node_quantity = 1
+				plan = "vc2-2c-4gb"
+				label = "tf-test-label"
+			}
+		}`, label, firewall)
+
+	if firewall {
+		config += `
+		resource "vultr_firewall_rule" "api" {
+			firewall_group_id = vultr_kubernetes.foo.firewall_group_id
+			protocol = "tcp"
+			ip_type = "v4"
+			subnet = "192.0.2.0"
+			subnet_size = 24
+			port = "6443"
+			notes = "tf-test-vke-api"
+		}`
+	}
+
+	return config
+}
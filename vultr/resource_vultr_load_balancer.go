@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -14,6 +15,12 @@ import (
 	"github.com/vultr/govultr/v3"
 )
 
+// lbGlobalRegionsReq sets the global regions of a load balancer, including to
+// an empty list
+type lbGlobalRegionsReq struct {
+	GlobalRegions []string `json:"global_regions"`
+}
+
 func resourceVultrLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceVultrLoadBalancerCreate,
@@ -23,6 +30,7 @@ func resourceVultrLoadBalancer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVultrLoadBalancerSSLDiff,
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:             schema.TypeString,
@@ -59,6 +67,33 @@ func resourceVultrLoadBalancer() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"nodes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateLBNodes,
+			},
+			"global_regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"http2": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"http3": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"health_check": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -111,8 +146,8 @@ func resourceVultrLoadBalancer() *schema.Resource {
 
 			"forwarding_rules": {
 				Type:     schema.TypeSet,
-				Required: true,
-				MinItems: 1,
+				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"frontend_protocol": {
@@ -144,33 +179,68 @@ func resourceVultrLoadBalancer() *schema.Resource {
 			},
 
 			"ssl": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_ssl"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"private_key": {
 							Type:         schema.TypeString,
 							Required:     true,
 							Sensitive:    true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validation.All(validation.NoZeroValues, validateLBPrivateKeyPEM),
 						},
 						"certificate": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.NoZeroValues,
+							ValidateFunc: validation.All(validation.NoZeroValues, validateLBCertificatePEM),
 						},
 						"chain": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateLBCertificatePEM,
+						},
+					},
+				},
+			},
+			"auto_ssl": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"ssl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_zone": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"domain_sub": {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
 					},
 				},
 			},
+			"ssl_certificate_subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ssl_certificate_sans": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ssl_certificate_not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
 			"firewall_rules": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"port": {
@@ -282,6 +352,18 @@ func resourceVultrLoadBalancerCreate(ctx context.Context, d *schema.ResourceData
 		ProxyProtocol:      govultr.BoolToBoolPtr(d.Get("proxy_protocol").(bool)),
 		BalancingAlgorithm: d.Get("balancing_algorithm").(string),
 		FirewallRules:      fwrMap,
+		AutoSSL:            generateAutoSSL(d.Get("auto_ssl")),
+		Nodes:              d.Get("nodes").(int),
+		GlobalRegions:      expandLBGlobalRegions(d.Get("global_regions")),
+		Timeout:            d.Get("timeout").(int),
+	}
+
+	if http2, ok := d.GetOk("http2"); ok {
+		req.HTTP2 = govultr.BoolToBoolPtr(http2.(bool))
+	}
+
+	if http3, ok := d.GetOk("http3"); ok {
+		req.HTTP3 = govultr.BoolToBoolPtr(http3.(bool))
 	}
 
 	if d.Get("vpc") != "" {
@@ -398,6 +480,46 @@ func resourceVultrLoadBalancerRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("unable to set resource load_balancer `vpc` read value: %v", err)
 	}
 
+	if err := d.Set("nodes", lb.Nodes); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `nodes` read value: %v", err)
+	}
+	if err := d.Set("global_regions", lb.GlobalRegions); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `global_regions` read value: %v", err)
+	}
+	if err := d.Set("http2", lb.HTTP2 != nil && *lb.HTTP2); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `http2` read value: %v", err)
+	}
+	if err := d.Set("http3", lb.HTTP3 != nil && *lb.HTTP3); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `http3` read value: %v", err)
+	}
+	if err := d.Set("timeout", lb.GenericInfo.Timeout); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `timeout` read value: %v", err)
+	}
+
+	var autoSSL []map[string]interface{}
+	if lb.AutoSSL != nil && lb.AutoSSL.DomainZone != "" {
+		autoSSL = append(autoSSL, map[string]interface{}{
+			"domain_zone": lb.AutoSSL.DomainZone,
+			"domain_sub":  lb.AutoSSL.DomainSub,
+		})
+	}
+	if err := d.Set("auto_ssl", autoSSL); err != nil {
+		return diag.Errorf("unable to set resource load_balancer `auto_ssl` read value: %v", err)
+	}
+
+	// the API doesn't return the certificate, so its metadata comes from the
+	// configured one
+	var certInfo *lbCertificateInfo
+	if sslData, sslOK := d.GetOk("ssl"); sslOK {
+		ssl := generateSSL(sslData)
+		if info, err := checkLBCertificate(ssl.Certificate, ssl.PrivateKey, ssl.Chain); err == nil {
+			certInfo = info
+		}
+	}
+	if err := setLBCertificateInfo(d, certInfo); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -412,6 +534,26 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 		BalancingAlgorithm: d.Get("balancing_algorithm").(string),
 	}
 
+	if d.HasChange("http2") {
+		req.HTTP2 = govultr.BoolToBoolPtr(d.Get("http2").(bool))
+	}
+
+	if d.HasChange("http3") {
+		req.HTTP3 = govultr.BoolToBoolPtr(d.Get("http3").(bool))
+	}
+
+	if d.HasChange("nodes") {
+		req.Nodes = d.Get("nodes").(int)
+	}
+
+	if d.HasChange("timeout") {
+		req.Timeout = d.Get("timeout").(int)
+	}
+
+	if d.HasChange("global_regions") {
+		req.GlobalRegions = expandLBGlobalRegions(d.Get("global_regions"))
+	}
+
 	if d.HasChange("health_check") {
 		health := d.Get("health_check")
 		req.HealthCheck = generateHealthCheck(health)
@@ -423,7 +565,20 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 			req.SSL = ssl
 		} else {
 			log.Printf(`[INFO] Removing load balancer SSL certificate (%v)`, d.Id())
-			req.SSL = nil
+			if err := client.LoadBalancer.DeleteSSL(ctx, d.Id()); err != nil {
+				return diag.Errorf("error removing SSL certificate from load balancer (%v): %v", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("auto_ssl") {
+		if autoSSL := generateAutoSSL(d.Get("auto_ssl")); autoSSL != nil {
+			req.AutoSSL = autoSSL
+		} else {
+			log.Printf(`[INFO] Removing load balancer auto SSL (%v)`, d.Id())
+			if err := client.LoadBalancer.DeleteAutoSSL(ctx, d.Id()); err != nil {
+				return diag.Errorf("error removing auto SSL from load balancer (%v): %v", d.Id(), err)
+			}
 		}
 	}
 
@@ -488,10 +643,27 @@ func resourceVultrLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData
 		req.VPC = govultr.StringToStringPtr(d.Get("vpc").(string))
 	}
 
+	meta.(*Client).lbLocks.Lock(d.Id())
+	defer meta.(*Client).lbLocks.Unlock(d.Id())
+
 	if err := client.LoadBalancer.Update(ctx, d.Id(), req); err != nil {
 		return diag.Errorf("error updating load balancer generic info (%v): %v", d.Id(), err)
 	}
 
+	// govultr omits an empty list, so removing the last global region needs
+	// its own request
+	if d.HasChange("global_regions") && len(req.GlobalRegions) == 0 {
+		if err := clearLBGlobalRegions(ctx, meta, d.Id()); err != nil {
+			return diag.Errorf("error removing global regions from load balancer (%v): %v", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("nodes", "global_regions") {
+		if _, err := waitForLBAvailable(ctx, d, "active", []string{"pending", "installing"}, "status", meta); err != nil {
+			return diag.Errorf("error while waiting for load balancer %v to be updated: %v", d.Id(), err)
+		}
+	}
+
 	return resourceVultrLoadBalancerRead(ctx, d, meta)
 }
 
@@ -612,6 +784,51 @@ func generateHealthCheck(health interface{}) *govultr.HealthCheck {
 	}
 }
 
+func expandLBGlobalRegions(regions interface{}) []string {
+	var list []string
+	for _, region := range regions.(*schema.Set).List() {
+		list = append(list, region.(string))
+	}
+	return list
+}
+
+func clearLBGlobalRegions(ctx context.Context, meta interface{}, lbID string) error {
+	client := meta.(*Client).govultrClient()
+
+	req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", lbPath, lbID), &lbGlobalRegionsReq{
+		GlobalRegions: []string{},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoWithContext(ctx, req, nil)
+	return err
+}
+
+// validateLBNodes checks the node count of a load balancer, which must be an
+// odd number from 1 to 99
+func validateLBNodes(v interface{}, k string) (ws []string, es []error) {
+	nodes := v.(int)
+	if nodes < 1 || nodes > 99 || nodes%2 == 0 { //nolint:mnd
+		es = append(es, fmt.Errorf("%q must be an odd number between 1 and 99, got %d", k, nodes))
+	}
+	return ws, es
+}
+
+func generateAutoSSL(autoSSLData interface{}) *govultr.AutoSSL {
+	k := autoSSLData.([]interface{})
+	if len(k) == 0 || k[0] == nil {
+		return nil
+	}
+	config := k[0].(map[string]interface{})
+
+	return &govultr.AutoSSL{
+		DomainZone: config["domain_zone"].(string),
+		DomainSub:  config["domain_sub"].(string),
+	}
+}
+
 func generateSSL(sslData interface{}) *govultr.SSL {
 	k := sslData.(*schema.Set).List()
 	config := k[0].(map[string]interface{})
@@ -622,3 +839,49 @@ func generateSSL(sslData interface{}) *govultr.SSL {
 		Chain:       config["chain"].(string),
 	}
 }
+
+// resourceVultrLoadBalancerSSLDiff checks the configured certificate against
+// its private key and chain, and plans the certificate metadata
+func resourceVultrLoadBalancerSSLDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("ssl") {
+		return nil
+	}
+
+	certificate, privateKey, chain, ok := lbSSLFromConfig(d)
+	if !ok {
+		if _, hasSSL := d.GetOk("ssl"); !hasSSL {
+			return clearLBCertificateInfo(d)
+		}
+
+		// some of the values are only known after apply
+		for _, key := range []string{"ssl_certificate_subject", "ssl_certificate_sans", "ssl_certificate_not_after"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	info, err := checkLBCertificate(certificate, privateKey, chain)
+	if err != nil {
+		return err
+	}
+
+	if err := d.SetNew("ssl_certificate_subject", info.Subject); err != nil {
+		return err
+	}
+	if err := d.SetNew("ssl_certificate_sans", info.SANs); err != nil {
+		return err
+	}
+	return d.SetNew("ssl_certificate_not_after", info.NotAfter)
+}
+
+func clearLBCertificateInfo(d *schema.ResourceDiff) error {
+	if err := d.SetNew("ssl_certificate_subject", ""); err != nil {
+		return err
+	}
+	if err := d.SetNew("ssl_certificate_sans", []string{}); err != nil {
+		return err
+	}
+	return d.SetNew("ssl_certificate_not_after", "")
+}
@@ -0,0 +1,187 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+// lbInstancesReq replaces the attached instances of a load balancer. It is
+// only used to detach the last instance, as govultr omits an empty list.
+type lbInstancesReq struct {
+	Instances []string `json:"instances"`
+}
+
+func resourceVultrLoadBalancerAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerAttachmentCreate,
+		ReadContext:   resourceVultrLoadBalancerAttachmentRead,
+		DeleteContext: resourceVultrLoadBalancerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrLoadBalancerAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceVultrLoadBalancerAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("lb_id").(string)
+	instanceID := d.Get("instance_id").(string)
+
+	meta.(*Client).lbLocks.Lock(lbID)
+	defer meta.(*Client).lbLocks.Unlock(lbID)
+
+	lb, _, err := client.LoadBalancer.Get(ctx, lbID)
+	if err != nil {
+		return diag.Errorf("error getting load balancer %s: %v", lbID, err)
+	}
+
+	for i := range lb.Instances {
+		if lb.Instances[i] == instanceID {
+			return diag.Errorf("instance %s is already attached to load balancer %s", instanceID, lbID)
+		}
+	}
+
+	log.Printf("[INFO] Attaching instance %s to load balancer %s", instanceID, lbID)
+
+	instances := append(lb.Instances, instanceID)
+	if err := updateLBInstances(ctx, d.Timeout(schema.TimeoutCreate), meta, lbID, instances); err != nil {
+		return diag.Errorf("error attaching instance %s to load balancer %s: %v", instanceID, lbID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", lbID, instanceID))
+
+	return resourceVultrLoadBalancerAttachmentRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("lb_id").(string)
+	instanceID := d.Get("instance_id").(string)
+
+	lb, _, err := client.LoadBalancer.Get(ctx, lbID)
+	if err != nil {
+		if strings.Contains(err.Error(), "\"status\":404") {
+			log.Printf("[WARN] Removing load balancer attachment (%s) because the load balancer is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting load balancer %s: %v", lbID, err)
+	}
+
+	for i := range lb.Instances {
+		if lb.Instances[i] == instanceID {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Removing load balancer attachment (%s) because it is gone", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVultrLoadBalancerAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("lb_id").(string)
+	instanceID := d.Get("instance_id").(string)
+
+	meta.(*Client).lbLocks.Lock(lbID)
+	defer meta.(*Client).lbLocks.Unlock(lbID)
+
+	lb, _, err := client.LoadBalancer.Get(ctx, lbID)
+	if err != nil {
+		if strings.Contains(err.Error(), "\"status\":404") {
+			return nil
+		}
+		return diag.Errorf("error getting load balancer %s: %v", lbID, err)
+	}
+
+	var instances []string
+	for i := range lb.Instances {
+		if lb.Instances[i] != instanceID {
+			instances = append(instances, lb.Instances[i])
+		}
+	}
+
+	if len(instances) == len(lb.Instances) {
+		return nil
+	}
+
+	log.Printf("[INFO] Detaching instance %s from load balancer %s", instanceID, lbID)
+
+	if err := updateLBInstances(ctx, d.Timeout(schema.TimeoutDelete), meta, lbID, instances); err != nil {
+		return diag.Errorf("error detaching instance %s from load balancer %s: %v", instanceID, lbID, err)
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	ids := strings.Split(d.Id(), "|")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		return nil, fmt.Errorf(
+			"unexpected format of load balancer attachment import ID (%s): expected 'lbID|instanceID'", d.Id())
+	}
+
+	if err := d.Set("lb_id", ids[0]); err != nil {
+		return nil, fmt.Errorf("unable to set `lb_id` for import state function")
+	}
+	if err := d.Set("instance_id", ids[1]); err != nil {
+		return nil, fmt.Errorf("unable to set `instance_id` for import state function")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateLBInstances sets the full list of instances attached to a load
+// balancer. Callers must hold the load balancer lock.
+func updateLBInstances(ctx context.Context, timeout time.Duration, meta interface{}, lbID string, instances []string) error { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	return retryLBNotReady(ctx, timeout, func() error {
+		if len(instances) != 0 {
+			return client.LoadBalancer.Update(ctx, lbID, &govultr.LoadBalancerReq{Instances: instances})
+		}
+
+		req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", lbPath, lbID), &lbInstancesReq{
+			Instances: []string{},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = client.DoWithContext(ctx, req, nil)
+		return err
+	})
+}
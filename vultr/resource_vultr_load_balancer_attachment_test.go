@@ -0,0 +1,131 @@
+package vultr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccVultrLoadBalancerAttachmentBasic(t *testing.T) {
+	skipCI(t)
+
+	name := "vultr_load_balancer_attachment.foo"
+	rLabel := acctest.RandomWithPrefix("tf-rs-lb-attach")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// both attachments update the same load balancer concurrently
+				Config: testAccVultrLoadBalancerAttachment(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVultrLoadBalancerAttachmentExists(name),
+					testAccCheckVultrLoadBalancerAttachmentExists("vultr_load_balancer_attachment.bar"),
+					resource.TestCheckResourceAttrSet(name, "lb_id"),
+					resource.TestCheckResourceAttrSet(name, "instance_id"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVultrLoadBalancerAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("load balancer attachment not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("load balancer attachment ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		lb, _, err := client.LoadBalancer.Get(context.Background(), rs.Primary.Attributes["lb_id"])
+		if err != nil {
+			return err
+		}
+
+		for _, id := range lb.Instances {
+			if id == rs.Primary.Attributes["instance_id"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("load balancer attachment does not exist: %s", rs.Primary.ID)
+	}
+}
+
+func testAccCheckVultrLoadBalancerAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_load_balancer_attachment" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+		lb, _, err := client.LoadBalancer.Get(context.Background(), rs.Primary.Attributes["lb_id"])
+		if err != nil {
+			// the load balancer is gone, so the attachment is too
+			continue
+		}
+
+		for _, id := range lb.Instances {
+			if id == rs.Primary.Attributes["instance_id"] {
+				return fmt.Errorf("load balancer attachment %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccVultrLoadBalancerAttachment(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "foo" {
+			region = "sea"
+			label  = "%[1]s"
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		resource "vultr_instance" "foo" {
+			plan = "vc2-1c-2gb"
+			region = "sea"
+			os_id = "167"
+			label = "%[1]s-foo"
+		}
+
+		resource "vultr_instance" "bar" {
+			plan = "vc2-1c-2gb"
+			region = "sea"
+			os_id = "167"
+			label = "%[1]s-bar"
+		}
+
+		resource "vultr_load_balancer_attachment" "foo" {
+			lb_id       = vultr_load_balancer.foo.id
+			instance_id = vultr_instance.foo.id
+		}
+
+		resource "vultr_load_balancer_attachment" "bar" {
+			lb_id       = vultr_load_balancer.foo.id
+			instance_id = vultr_instance.bar.id
+		}
+	`, label)
+}
@@ -0,0 +1,242 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+const lbPath = "/v2/load-balancers"
+
+// lbFirewallRulesReq replaces the full firewall rule list of a load balancer.
+// govultr omits an empty list, which would make removing the last rule a
+// no-op, so the request is sent without omitempty.
+type lbFirewallRulesReq struct {
+	FirewallRules []govultr.LBFirewallRule `json:"firewall_rules"`
+}
+
+func resourceVultrLoadBalancerFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerFirewallRuleCreate,
+		ReadContext:   resourceVultrLoadBalancerFirewallRuleRead,
+		DeleteContext: resourceVultrLoadBalancerFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrLoadBalancerRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+			"ip_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"v4", "v6"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceVultrLoadBalancerFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	lbID := d.Get("load_balancer_id").(string)
+	rule := govultr.LBFirewallRule{
+		Port:   d.Get("port").(int),
+		IPType: d.Get("ip_type").(string),
+		Source: d.Get("source").(string),
+	}
+
+	meta.(*Client).lbLocks.Lock(lbID)
+	defer meta.(*Client).lbLocks.Unlock(lbID)
+
+	rules, err := listLBFirewallRules(ctx, meta, lbID)
+	if err != nil {
+		return diag.Errorf("error getting firewall rules for load balancer %s: %v", lbID, err)
+	}
+
+	if existing := findLBFirewallRule(rules, rule); existing != nil {
+		return diag.Errorf("load balancer %s already has a firewall rule (%s) for port %d from %s (%s)",
+			lbID, existing.RuleID, rule.Port, rule.Source, rule.IPType)
+	}
+
+	log.Printf("[INFO] Creating firewall rule on load balancer %s", lbID)
+
+	if err := updateLBFirewallRules(ctx, d.Timeout(schema.TimeoutCreate), meta, lbID, append(rules, rule)); err != nil {
+		return diag.Errorf("error creating firewall rule on load balancer %s: %v", lbID, err)
+	}
+
+	rules, err = listLBFirewallRules(ctx, meta, lbID)
+	if err != nil {
+		return diag.Errorf("error getting firewall rules for load balancer %s: %v", lbID, err)
+	}
+
+	created := findLBFirewallRule(rules, rule)
+	if created == nil {
+		return diag.Errorf("firewall rule for port %d from %s was not found on load balancer %s after creation",
+			rule.Port, rule.Source, lbID)
+	}
+
+	d.SetId(created.RuleID)
+
+	return resourceVultrLoadBalancerFirewallRuleRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+
+	rule, _, err := client.LoadBalancer.GetFirewallRule(ctx, lbID, d.Id())
+	if err != nil {
+		if !strings.Contains(err.Error(), "\"status\":404") {
+			return diag.Errorf("error getting firewall rule %s on load balancer %s: %v", d.Id(), lbID, err)
+		}
+
+		// the whole list is replaced whenever any rule changes, which can
+		// reissue rule IDs, so look the rule up by its attributes
+		rules, errList := listLBFirewallRules(ctx, meta, lbID)
+		if errList != nil && !strings.Contains(errList.Error(), "\"status\":404") {
+			return diag.Errorf("error getting firewall rules for load balancer %s: %v", lbID, errList)
+		}
+
+		rule = findLBFirewallRule(rules, govultr.LBFirewallRule{
+			Port:   d.Get("port").(int),
+			IPType: d.Get("ip_type").(string),
+			Source: d.Get("source").(string),
+		})
+		if rule == nil {
+			log.Printf("[WARN] Removing load balancer firewall rule (%s) because it is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		d.SetId(rule.RuleID)
+	}
+
+	if err := d.Set("port", rule.Port); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_firewall_rule `port` read value: %v", err)
+	}
+	if err := d.Set("ip_type", rule.IPType); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_firewall_rule `ip_type` read value: %v", err)
+	}
+	if err := d.Set("source", rule.Source); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_firewall_rule `source` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	lbID := d.Get("load_balancer_id").(string)
+
+	meta.(*Client).lbLocks.Lock(lbID)
+	defer meta.(*Client).lbLocks.Unlock(lbID)
+
+	rules, err := listLBFirewallRules(ctx, meta, lbID)
+	if err != nil {
+		if strings.Contains(err.Error(), "\"status\":404") {
+			return nil
+		}
+		return diag.Errorf("error getting firewall rules for load balancer %s: %v", lbID, err)
+	}
+
+	var remaining []govultr.LBFirewallRule
+	for i := range rules {
+		if rules[i].RuleID != d.Id() {
+			remaining = append(remaining, rules[i])
+		}
+	}
+
+	if len(remaining) == len(rules) {
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting firewall rule %s from load balancer %s", d.Id(), lbID)
+
+	if err := updateLBFirewallRules(ctx, d.Timeout(schema.TimeoutDelete), meta, lbID, remaining); err != nil {
+		return diag.Errorf("error deleting firewall rule %s from load balancer %s: %v", d.Id(), lbID, err)
+	}
+
+	return nil
+}
+
+func listLBFirewallRules(ctx context.Context, meta interface{}, lbID string) ([]govultr.LBFirewallRule, error) {
+	client := meta.(*Client).govultrClient()
+
+	var rules []govultr.LBFirewallRule
+	options := &govultr.ListOptions{}
+	for {
+		page, m, _, err := client.LoadBalancer.ListFirewallRules(ctx, lbID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, page...)
+
+		if m.Links.Next == "" {
+			break
+		}
+		options.Cursor = m.Links.Next
+	}
+
+	return rules, nil
+}
+
+func findLBFirewallRule(rules []govultr.LBFirewallRule, want govultr.LBFirewallRule) *govultr.LBFirewallRule {
+	for i := range rules {
+		if rules[i].Port == want.Port && rules[i].IPType == want.IPType && rules[i].Source == want.Source {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func updateLBFirewallRules(ctx context.Context, timeout time.Duration, meta interface{}, lbID string, rules []govultr.LBFirewallRule) error { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	// the API assigns rule IDs, so only the rule attributes are sent
+	body := &lbFirewallRulesReq{FirewallRules: []govultr.LBFirewallRule{}}
+	for i := range rules {
+		body.FirewallRules = append(body.FirewallRules, govultr.LBFirewallRule{
+			Port:   rules[i].Port,
+			IPType: rules[i].IPType,
+			Source: rules[i].Source,
+		})
+	}
+
+	return retryLBNotReady(ctx, timeout, func() error {
+		req, err := client.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("%s/%s", lbPath, lbID), body)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.DoWithContext(ctx, req, nil)
+		return err
+	})
+}
@@ -0,0 +1,86 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceVultrLoadBalancerFirewallRule(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-lb-fw")
+
+	name := "vultr_load_balancer_firewall_rule.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerFirewallRuleConfig(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "load_balancer_id"),
+					resource.TestCheckResourceAttr(name, "port", "80"),
+					resource.TestCheckResourceAttr(name, "ip_type", "v4"),
+					resource.TestCheckResourceAttr(name, "source", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr("vultr_load_balancer_firewall_rule.bar", "ip_type", "v6"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVultrLoadBalancerRuleImportID(name),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrLoadBalancerFirewallRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_load_balancer_firewall_rule" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+
+		lbID := rs.Primary.Attributes["load_balancer_id"]
+		_, _, err := client.LoadBalancer.GetFirewallRule(context.Background(), lbID, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("load balancer firewall rule still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccVultrLoadBalancerFirewallRuleConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "foo" {
+			region = "ewr"
+			label  = "%s"
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}
+
+		resource "vultr_load_balancer_firewall_rule" "foo" {
+			load_balancer_id = vultr_load_balancer.foo.id
+			port             = 80
+			ip_type          = "v4"
+			source           = "192.0.2.0/24"
+		}
+
+		resource "vultr_load_balancer_firewall_rule" "bar" {
+			load_balancer_id = vultr_load_balancer.foo.id
+			port             = 80
+			ip_type          = "v6"
+			source           = "2001:db8::/32"
+		}`, label)
+}
@@ -0,0 +1,177 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vultr/govultr/v3"
+)
+
+func resourceVultrLoadBalancerForwardingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVultrLoadBalancerForwardingRuleCreate,
+		ReadContext:   resourceVultrLoadBalancerForwardingRuleRead,
+		DeleteContext: resourceVultrLoadBalancerForwardingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVultrLoadBalancerRuleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"frontend_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp"}, false),
+			},
+			"frontend_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+			"backend_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "https", "tcp"}, false),
+			},
+			"backend_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 65535), //nolint:mnd
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceVultrLoadBalancerForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+	req := &govultr.ForwardingRule{
+		FrontendProtocol: d.Get("frontend_protocol").(string),
+		FrontendPort:     d.Get("frontend_port").(int),
+		BackendProtocol:  d.Get("backend_protocol").(string),
+		BackendPort:      d.Get("backend_port").(int),
+	}
+
+	meta.(*Client).lbLocks.Lock(lbID)
+	defer meta.(*Client).lbLocks.Unlock(lbID)
+
+	log.Printf("[INFO] Creating forwarding rule on load balancer %s", lbID)
+
+	var rule *govultr.ForwardingRule
+	err := retryLBNotReady(ctx, d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
+		rule, _, err = client.LoadBalancer.CreateForwardingRule(ctx, lbID, req)
+		return err
+	})
+	if err != nil {
+		return diag.Errorf("error creating forwarding rule on load balancer %s: %v", lbID, err)
+	}
+
+	d.SetId(rule.RuleID)
+
+	return resourceVultrLoadBalancerForwardingRuleRead(ctx, d, meta)
+}
+
+func resourceVultrLoadBalancerForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+
+	rule, _, err := client.LoadBalancer.GetForwardingRule(ctx, lbID, d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "\"status\":404") {
+			log.Printf("[WARN] Removing load balancer forwarding rule (%s) because it is gone", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error getting forwarding rule %s on load balancer %s: %v", d.Id(), lbID, err)
+	}
+
+	if err := d.Set("frontend_protocol", rule.FrontendProtocol); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `frontend_protocol` read value: %v", err)
+	}
+	if err := d.Set("frontend_port", rule.FrontendPort); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `frontend_port` read value: %v", err)
+	}
+	if err := d.Set("backend_protocol", rule.BackendProtocol); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `backend_protocol` read value: %v", err)
+	}
+	if err := d.Set("backend_port", rule.BackendPort); err != nil {
+		return diag.Errorf("unable to set resource load_balancer_forwarding_rule `backend_port` read value: %v", err)
+	}
+
+	return nil
+}
+
+func resourceVultrLoadBalancerForwardingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { //nolint:lll
+	client := meta.(*Client).govultrClient()
+
+	lbID := d.Get("load_balancer_id").(string)
+
+	meta.(*Client).lbLocks.Lock(lbID)
+	defer meta.(*Client).lbLocks.Unlock(lbID)
+
+	log.Printf("[INFO] Deleting forwarding rule %s from load balancer %s", d.Id(), lbID)
+
+	err := retryLBNotReady(ctx, d.Timeout(schema.TimeoutDelete), func() error {
+		return client.LoadBalancer.DeleteForwardingRule(ctx, lbID, d.Id())
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "\"status\":404") {
+			return nil
+		}
+		return diag.Errorf("error deleting forwarding rule %s from load balancer %s: %v", d.Id(), lbID, err)
+	}
+
+	return nil
+}
+
+// resourceVultrLoadBalancerRuleImport imports load balancer rules by
+// "loadBalancerID,ruleID"
+func resourceVultrLoadBalancerRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) { //nolint:lll
+	lbID, ruleID, ok := strings.Cut(d.Id(), ",")
+	if !ok || lbID == "" || ruleID == "" {
+		return nil, fmt.Errorf(`invalid import format, expected "loadBalancerID,ruleID"`)
+	}
+
+	d.SetId(ruleID)
+	if err := d.Set("load_balancer_id", lbID); err != nil {
+		return nil, fmt.Errorf("unable to set `load_balancer_id` import value: %v", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// retryLBNotReady retries fn while the load balancer is still applying a
+// previous change, which the API reports as not ready
+func retryLBNotReady(ctx context.Context, timeout time.Duration, fn func() error) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := fn(); err != nil {
+			if strings.Contains(err.Error(), "Load balancer is not ready.") {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
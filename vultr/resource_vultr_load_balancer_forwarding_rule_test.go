@@ -0,0 +1,84 @@
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceVultrLoadBalancerForwardingRule(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-lb-fwd")
+
+	name := "vultr_load_balancer_forwarding_rule.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerForwardingRuleConfig(rLabel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "load_balancer_id"),
+					resource.TestCheckResourceAttr(name, "frontend_protocol", "tcp"),
+					resource.TestCheckResourceAttr(name, "frontend_port", "8080"),
+					resource.TestCheckResourceAttr(name, "backend_protocol", "tcp"),
+					resource.TestCheckResourceAttr(name, "backend_port", "8081"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccVultrLoadBalancerRuleImportID(name),
+			},
+		},
+	})
+}
+
+func testAccCheckVultrLoadBalancerForwardingRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vultr_load_balancer_forwarding_rule" {
+			continue
+		}
+
+		client := testAccProvider.Meta().(*Client).govultrClient()
+
+		lbID := rs.Primary.Attributes["load_balancer_id"]
+		_, _, err := client.LoadBalancer.GetForwardingRule(context.Background(), lbID, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("load balancer forwarding rule still exists: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccVultrLoadBalancerRuleImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["load_balancer_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccVultrLoadBalancerForwardingRuleConfig(label string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "foo" {
+			region = "ewr"
+			label  = "%s"
+		}
+
+		resource "vultr_load_balancer_forwarding_rule" "foo" {
+			load_balancer_id  = vultr_load_balancer.foo.id
+			frontend_protocol = "tcp"
+			frontend_port     = 8080
+			backend_protocol  = "tcp"
+			backend_port      = 8081
+		}`, label)
+}
@@ -2,8 +2,17 @@ package vultr
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -102,6 +111,71 @@ func TestAccResourceVultrLoadBalancerUpdateVPC(t *testing.T) {
 	})
 }
 
+func TestAccResourceVultrLoadBalancerAdvanced(t *testing.T) {
+	skipCI(t)
+	rLabel := acctest.RandomWithPrefix("tf-lb-rs")
+
+	name := "vultr_load_balancer.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVultrLoadBalancerConfigAdvanced(rLabel, 1, false, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "nodes", "1"),
+					resource.TestCheckResourceAttr(name, "http2", "false"),
+					resource.TestCheckResourceAttr(name, "timeout", "600"),
+				),
+			},
+			{
+				Config: testAccVultrLoadBalancerConfigAdvanced(rLabel, 3, true, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "nodes", "3"),
+					resource.TestCheckResourceAttr(name, "http2", "true"),
+					resource.TestCheckResourceAttr(name, "timeout", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceVultrLoadBalancerSSL(t *testing.T) {
+	rLabel := acctest.RandomWithPrefix("tf-lb-rs")
+
+	caCert, caKey := testAccVultrLoadBalancerCertificate(t, "tf-acc-ca", "", "")
+	leafCert, leafKey := testAccVultrLoadBalancerCertificate(t, "lb.example.com", caCert, caKey)
+	_, otherKey := testAccVultrLoadBalancerCertificate(t, "other.example.com", "", "")
+
+	name := "vultr_load_balancer.foo"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckVultrLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVultrLoadBalancerConfigSSL(rLabel, leafCert, otherKey, caCert),
+				ExpectError: regexp.MustCompile("`private_key` does not match `certificate`"),
+			},
+			{
+				Config:      testAccVultrLoadBalancerConfigSSL(rLabel, leafCert, leafKey, leafCert),
+				ExpectError: regexp.MustCompile("`chain` is out of order"),
+			},
+			{
+				Config: testAccVultrLoadBalancerConfigSSL(rLabel, leafCert, leafKey, caCert),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "has_ssl", "true"),
+					resource.TestCheckResourceAttr(name, "ssl_certificate_subject", "CN=lb.example.com"),
+					resource.TestCheckResourceAttr(name, "ssl_certificate_sans.#", "1"),
+					resource.TestCheckResourceAttr(name, "ssl_certificate_sans.0", "lb.example.com"),
+					resource.TestCheckResourceAttrSet(name, "ssl_certificate_not_after"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVultrLoadBalancerDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "vultr_load_balancer" {
@@ -180,3 +254,95 @@ func testAccVultrLoadBalancerConfigUpdateVPC(label string) string {
 
 		}`, label)
 }
+
+func testAccVultrLoadBalancerConfigAdvanced(label string, nodes int, http2 bool, timeout int) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "foo" {
+			region  = "ewr"
+			label   = "%s"
+			nodes   = %d
+			http2   = %t
+			timeout = %d
+
+			forwarding_rules {
+				frontend_protocol = "http"
+				frontend_port     = 80
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+		}`, label, nodes, http2, timeout)
+}
+
+func testAccVultrLoadBalancerConfigSSL(label, certificate, privateKey, chain string) string {
+	return fmt.Sprintf(`
+		resource "vultr_load_balancer" "foo" {
+			region = "ewr"
+			label  = "%s"
+
+			forwarding_rules {
+				frontend_protocol = "https"
+				frontend_port     = 443
+				backend_protocol  = "http"
+				backend_port      = 80
+			}
+
+			ssl {
+				certificate = <<EOT
+%sEOT
+				private_key = <<EOT
+%sEOT
+				chain = <<EOT
+%sEOT
+			}
+		}`, label, certificate, privateKey, chain)
+}
+
+// testAccVultrLoadBalancerCertificate returns a PEM certificate and key for
+// name, signed by parent or self-signed when parent is nil
+func testAccVultrLoadBalancerCertificate(t *testing.T, name string, parentPEM, parentKeyPEM string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(90 * 24 * time.Hour),
+		BasicConstraintsValid: true,
+	}
+
+	parent, signer := tmpl, key
+	if parentPEM == "" {
+		tmpl.IsCA = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		tmpl.DNSNames = []string{name}
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+
+		block, _ := pem.Decode([]byte(parentPEM))
+		if parent, err = x509.ParseCertificate(block.Bytes); err != nil {
+			t.Fatal(err)
+		}
+		keyBlock, _ := pem.Decode([]byte(parentKeyPEM))
+		if signer, err = x509.ParseECPrivateKey(keyBlock.Bytes); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
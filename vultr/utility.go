@@ -41,6 +41,30 @@ func diffSlice(x, y []string) []string {
 	return diff
 }
 
+// isAttrConfigured reports whether a top level attribute is set in the
+// configuration, as opposed to only being present in state because it is
+// computed
+func isAttrConfigured(d *schema.ResourceData, key string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+
+	v := raw.GetAttr(key)
+	if !v.IsKnown() {
+		return true
+	}
+	if v.IsNull() {
+		return false
+	}
+
+	if v.Type().IsListType() || v.Type().IsSetType() || v.Type().IsMapType() {
+		return v.LengthInt() > 0
+	}
+
+	return true
+}
+
 // IgnoreCase implement a DiffSupressFunc to ignore case
 func IgnoreCase(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(old, new)
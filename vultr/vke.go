@@ -2,31 +2,99 @@ package vultr
 
 import (
 	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"gopkg.in/yaml.v2"
 )
 
+// KubeConfig is a kubeconfig file as described in
+// https://kubernetes.io/docs/reference/config-api/kubeconfig.v1/
 type KubeConfig struct {
-	APIVersion string `yaml:"apiVersion"`
-	Kind       string `yaml:"kind"`
-	Clusters   []struct {
-		Name    string `yaml:"name"`
-		Cluster struct {
-			CaCert string `yaml:"certificate-authority-data"`
-			Server string `yaml:"server"`
-		} `yaml:"cluster"`
-	} `yaml:"clusters"`
-	Users []struct {
-		Name string `yaml:"name"`
-		User struct {
-			ClientCert string `yaml:"client-certificate-data"`
-			ClientKey  string `yaml:"client-key-data"`
-		} `yaml:"user"`
-	} `yaml:"users"`
+	APIVersion     string                   `yaml:"apiVersion"`
+	Kind           string                   `yaml:"kind"`
+	Preferences    struct{}                 `yaml:"preferences"`
+	Clusters       []KubeConfigNamedCluster `yaml:"clusters"`
+	Users          []KubeConfigNamedUser    `yaml:"users"`
+	Contexts       []KubeConfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
 }
 
+// KubeConfigNamedCluster is a named entry in the kubeconfig clusters list
+type KubeConfigNamedCluster struct {
+	Name    string            `yaml:"name"`
+	Cluster KubeConfigCluster `yaml:"cluster"`
+}
+
+// KubeConfigCluster holds the API server address and how to trust it
+type KubeConfigCluster struct {
+	Server                   string `yaml:"server"`
+	TLSServerName            string `yaml:"tls-server-name,omitempty"`
+	InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify,omitempty"`
+	CertificateAuthority     string `yaml:"certificate-authority,omitempty"`
+	CertificateAuthorityData string `yaml:"certificate-authority-data,omitempty"`
+	ProxyURL                 string `yaml:"proxy-url,omitempty"`
+}
+
+// KubeConfigNamedUser is a named entry in the kubeconfig users list
+type KubeConfigNamedUser struct {
+	Name string         `yaml:"name"`
+	User KubeConfigUser `yaml:"user"`
+}
+
+// KubeConfigUser holds the credentials used to authenticate to a cluster,
+// either a client certificate, a bearer token, basic auth or an exec plugin
+type KubeConfigUser struct {
+	ClientCertificate     string              `yaml:"client-certificate,omitempty"`
+	ClientCertificateData string              `yaml:"client-certificate-data,omitempty"`
+	ClientKey             string              `yaml:"client-key,omitempty"`
+	ClientKeyData         string              `yaml:"client-key-data,omitempty"`
+	Token                 string              `yaml:"token,omitempty"`
+	TokenFile             string              `yaml:"tokenFile,omitempty"`
+	Username              string              `yaml:"username,omitempty"`
+	Password              string              `yaml:"password,omitempty"`
+	Exec                  *KubeConfigExecUser `yaml:"exec,omitempty"`
+}
+
+// KubeConfigExecUser runs a command to fetch credentials
+type KubeConfigExecUser struct {
+	APIVersion         string                 `yaml:"apiVersion"`
+	Command            string                 `yaml:"command"`
+	Args               []string               `yaml:"args,omitempty"`
+	Env                []KubeConfigExecEnvVar `yaml:"env,omitempty"`
+	InstallHint        string                 `yaml:"installHint,omitempty"`
+	ProvideClusterInfo bool                   `yaml:"provideClusterInfo,omitempty"`
+	InteractiveMode    string                 `yaml:"interactiveMode,omitempty"`
+}
+
+// KubeConfigExecEnvVar is an environment variable passed to an exec plugin
+type KubeConfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// KubeConfigNamedContext is a named entry in the kubeconfig contexts list
+type KubeConfigNamedContext struct {
+	Name    string            `yaml:"name"`
+	Context KubeConfigContext `yaml:"context"`
+}
+
+// KubeConfigContext pairs a cluster with the user to access it as
+type KubeConfigContext struct {
+	Cluster   string `yaml:"cluster"`
+	User      string `yaml:"user"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+const (
+	nodePoolReplacementReplace = "replace"
+	nodePoolReplacementSurge   = "surge"
+)
+
 func nodePoolSchema(isNodePool bool) map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"label": {
@@ -38,9 +106,10 @@ func nodePoolSchema(isNodePool bool) map[string]*schema.Schema {
 			Required: true,
 		},
 		"node_quantity": {
-			Type:         schema.TypeInt,
-			ValidateFunc: validation.IntAtLeast(1),
-			Required:     true,
+			Type:             schema.TypeInt,
+			ValidateFunc:     validation.IntAtLeast(1),
+			Required:         true,
+			DiffSuppressFunc: suppressAutoScaledNodeQuantity,
 		},
 		"auto_scaler": {
 			Type:     schema.TypeBool,
@@ -78,15 +147,38 @@ func nodePoolSchema(isNodePool bool) map[string]*schema.Schema {
 						Required: true,
 					},
 					"effect": {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}, false),
 					},
 				},
 			},
 		},
 		"user_data": {
-			Type:     schema.TypeString,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateUserData(true),
+		},
+		"replacement_strategy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      nodePoolReplacementReplace,
+			ValidateFunc: validation.StringInSlice([]string{nodePoolReplacementReplace, nodePoolReplacementSurge}, false),
+		},
+		"recycle_triggers": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"remove_nodes": {
+			Type:     schema.TypeSet,
 			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
 		},
 		//computed fields
 		"id": {
@@ -105,6 +197,10 @@ func nodePoolSchema(isNodePool bool) map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"current_node_quantity": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
 		"nodes": {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -154,18 +250,278 @@ func nodePoolSchema(isNodePool bool) map[string]*schema.Schema {
 	return s
 }
 
-func getCertsFromKubeConfig(kubeconfig string) (ca string, cert string, key string, err error) {
-	decodedKC, err := base64.StdEncoding.DecodeString(kubeconfig)
+// suppressAutoScaledNodeQuantity ignores node_quantity changes while the auto
+// scaler stays enabled, since the live count is managed by the auto scaler
+// rather than the config. The configured count still applies in the apply
+// that turns the auto scaler on or off.
+func suppressAutoScaledNodeQuantity(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" {
+		return false
+	}
+
+	prefix := strings.TrimSuffix(k, "node_quantity")
+	oldAutoScaler, newAutoScaler := d.GetChange(prefix + "auto_scaler")
+	return oldAutoScaler.(bool) && newAutoScaler.(bool)
+}
+
+// validateNodePoolScaling checks that an auto scaled node pool has
+// min_nodes <= node_quantity <= max_nodes. prefix locates the node pool
+// attributes in the diff and configPath locates node_quantity in the raw
+// config, whose value isn't affected by the auto scaler diff suppression.
+func validateNodePoolScaling(d *schema.ResourceDiff, prefix string, configPath ...string) error {
+	if !d.Get(prefix + "auto_scaler").(bool) {
+		return nil
+	}
+	if !d.NewValueKnown(prefix+"min_nodes") || !d.NewValueKnown(prefix+"max_nodes") {
+		return nil
+	}
+
+	minNodes := d.Get(prefix + "min_nodes").(int)
+	maxNodes := d.Get(prefix + "max_nodes").(int)
+	if minNodes > maxNodes {
+		return fmt.Errorf("min_nodes (%d) must not be greater than max_nodes (%d)", minNodes, maxNodes)
+	}
+
+	v := d.GetRawConfig()
+	for _, key := range configPath {
+		if v.IsNull() || !v.IsKnown() {
+			return nil
+		}
+		if v.Type().IsListType() {
+			items := v.AsValueSlice()
+			if len(items) == 0 {
+				return nil
+			}
+			v = items[0]
+		}
+		v = v.GetAttr(key)
+	}
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	quantity, _ := v.AsBigFloat().Int64()
+	if quantity < int64(minNodes) || quantity > int64(maxNodes) {
+		return fmt.Errorf("node_quantity (%d) must be between min_nodes (%d) and max_nodes (%d) when auto_scaler is enabled",
+			quantity, minNodes, maxNodes)
+	}
+
+	return nil
+}
+
+// vkeVersionParts splits a VKE version such as v1.29.4+1 into its numeric
+// major, minor, patch and build components. Missing or malformed components
+// are treated as zero.
+func vkeVersionParts(version string) [4]int {
+	var parts [4]int
+
+	core, build, _ := strings.Cut(strings.TrimPrefix(version, "v"), "+")
+	for i, n := range strings.SplitN(core, ".", 3) {
+		parts[i], _ = strconv.Atoi(n)
+	}
+	parts[3], _ = strconv.Atoi(build)
+
+	return parts
+}
+
+// compareVKEVersions returns -1, 0 or 1 depending on whether a is older than,
+// the same as, or newer than b
+func compareVKEVersions(a, b string) int {
+	pa, pb := vkeVersionParts(a), vkeVersionParts(b)
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1
+		case pa[i] > pb[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// vkeVersionHasPrefix reports whether version falls under prefix on a
+// component boundary, so v1.2 matches v1.2.3+1 but not v1.29.0+1
+func vkeVersionHasPrefix(version, prefix string) bool {
+	version = strings.TrimPrefix(version, "v")
+	prefix = strings.TrimPrefix(prefix, "v")
+
+	if prefix == "" || version == prefix {
+		return true
+	}
+
+	return strings.HasPrefix(version, prefix+".") || strings.HasPrefix(version, prefix+"+")
+}
+
+// filterVKEVersions returns the versions matching prefix, newest first
+func filterVKEVersions(versions []string, prefix string) []string {
+	var matched []string
+	for _, v := range versions {
+		if vkeVersionHasPrefix(v, prefix) {
+			matched = append(matched, v)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return compareVKEVersions(matched[i], matched[j]) > 0
+	})
+
+	return matched
+}
+
+// parseKubeConfig decodes the base64 encoded kubeconfig returned by the API
+func parseKubeConfig(encoded string) (*KubeConfig, []byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
-		return "", "", "", err
+		return nil, nil, fmt.Errorf("kubeconfig is not valid base64: %v", err)
+	}
+
+	kc := new(KubeConfig)
+	if err := yaml.Unmarshal(raw, kc); err != nil {
+		return nil, nil, fmt.Errorf("kubeconfig is not valid YAML: %v", err)
+	}
+
+	return kc, raw, nil
+}
+
+// resolveContext returns the context, cluster and user selected by the
+// current context. If current-context isn't set the first context is used,
+// and if there are no contexts at all the first cluster and user are paired.
+func (kc *KubeConfig) resolveContext() (*KubeConfigNamedContext, *KubeConfigNamedCluster, *KubeConfigNamedUser, error) {
+	var ctx *KubeConfigNamedContext
+	for i := range kc.Contexts {
+		if kc.CurrentContext == "" || kc.Contexts[i].Name == kc.CurrentContext {
+			ctx = &kc.Contexts[i]
+			break
+		}
+	}
+
+	if ctx == nil {
+		if kc.CurrentContext != "" {
+			return nil, nil, nil, fmt.Errorf("kubeconfig current-context %q does not exist", kc.CurrentContext)
+		}
+		if len(kc.Clusters) == 0 || len(kc.Users) == 0 {
+			return nil, nil, nil, fmt.Errorf("kubeconfig has no contexts and is missing a cluster or user")
+		}
+		ctx = &KubeConfigNamedContext{
+			Name:    kc.Clusters[0].Name,
+			Context: KubeConfigContext{Cluster: kc.Clusters[0].Name, User: kc.Users[0].Name},
+		}
+	}
+
+	var cluster *KubeConfigNamedCluster
+	for i := range kc.Clusters {
+		if kc.Clusters[i].Name == ctx.Context.Cluster {
+			cluster = &kc.Clusters[i]
+			break
+		}
+	}
+	if cluster == nil {
+		return nil, nil, nil, fmt.Errorf("kubeconfig context %q refers to missing cluster %q", ctx.Name, ctx.Context.Cluster)
 	}
 
-	var kc KubeConfig
+	var user *KubeConfigNamedUser
+	for i := range kc.Users {
+		if kc.Users[i].Name == ctx.Context.User {
+			user = &kc.Users[i]
+			break
+		}
+	}
+	if user == nil {
+		return nil, nil, nil, fmt.Errorf("kubeconfig context %q refers to missing user %q", ctx.Name, ctx.Context.User)
+	}
 
-	err = yaml.Unmarshal(decodedKC, &kc)
+	return ctx, cluster, user, nil
+}
+
+// renderKubeConfig returns a kubeconfig holding only the current context,
+// with the context, cluster and user all named contextName so it can be
+// merged with other kubeconfigs without clashes. An empty contextName keeps
+// the current context's name.
+func (kc *KubeConfig) renderKubeConfig(contextName string) (string, error) {
+	ctx, cluster, user, err := kc.resolveContext()
+	if err != nil {
+		return "", err
+	}
+
+	if contextName == "" {
+		contextName = ctx.Name
+	}
+
+	out := KubeConfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []KubeConfigNamedCluster{{Name: contextName, Cluster: cluster.Cluster}},
+		Users:          []KubeConfigNamedUser{{Name: contextName, User: user.User}},
+		CurrentContext: contextName,
+		Contexts: []KubeConfigNamedContext{{
+			Name: contextName,
+			Context: KubeConfigContext{
+				Cluster:   contextName,
+				User:      contextName,
+				Namespace: ctx.Context.Namespace,
+			},
+		}},
+	}
+
+	rendered, err := yaml.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+
+	return string(rendered), nil
+}
+
+// kubeConfigAttributes flattens the current context of a kubeconfig into the
+// attributes shared by the kubernetes resource and data source
+func kubeConfigAttributes(kc *KubeConfig, raw []byte) (map[string]interface{}, error) {
+	_, cluster, user, err := kc.resolveContext()
 	if err != nil {
-		return "", "", "", err
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"host":                   cluster.Cluster.Server,
+		"kube_config_raw":        string(raw),
+		"cluster_ca_certificate": cluster.Cluster.CertificateAuthorityData,
+		"client_certificate":     user.User.ClientCertificateData,
+		"client_key":             user.User.ClientKeyData,
+		"token":                  user.User.Token,
+	}, nil
+}
+
+// setKubeConfigAttributes parses the base64 encoded kubeconfig from the API
+// and sets the kubeconfig attributes, including the certificates that were
+// previously pulled out of it by index
+func setKubeConfigAttributes(d *schema.ResourceData, encoded string) error {
+	kc, raw, err := parseKubeConfig(encoded)
+	if err != nil {
+		return err
+	}
+
+	attrs, err := kubeConfigAttributes(kc, raw)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := kc.renderKubeConfig(d.Get("kube_config_context_name").(string))
+	if err != nil {
+		return err
+	}
+	attrs["kube_config_rendered"] = rendered
+	attrs["kube_config"] = encoded
+
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := d.Set(k, attrs[k]); err != nil {
+			return fmt.Errorf("unable to set `%s` read value: %v", k, err)
+		}
 	}
 
-	return kc.Clusters[0].Cluster.CaCert, kc.Users[0].User.ClientCert, kc.Users[0].User.ClientKey, nil
+	return nil
 }